@@ -1,8 +1,9 @@
 package bahttext
 
 import (
-	"fmt"
+	"errors"
 	"math"
+	"math/big"
 	"strconv"
 	"strings"
 )
@@ -12,9 +13,16 @@ var (
 	unitPlaces = []string{"", "สิบ", "ร้อย", "พัน", "หมื่น", "แสน", "ล้าน"}
 )
 
+// maxExactAmount is the largest magnitude, 2^53, up to which float64 can still
+// represent every whole baht.
+const maxExactAmount = 1 << 53
+
 // Words converts a float64 amount into its Thai word representation.
-// It returns the converted string
-// Don't be foolish and pass nonsensical values to this function such as NaN, Inf, or extremely large numbers.
+// It returns the converted string.
+// Words delegates to WordsE and panics if WordsE would return an error, that is
+// for NaN, Inf, or amounts beyond ±2^53, too large to be represented exactly.
+// Larger amounts can be converted exactly with WordsBigInt or
+// WordsFromStringStrict.
 //
 // Example usage:
 //
@@ -27,45 +35,155 @@ var (
 //   - Large numbers: Words(1000000) -> "หนึ่งล้านบาทถ้วน"
 //   - Negative numbers: Words(-100) -> "ลบหนึ่งร้อยบาทถ้วน"
 func Words(money float64) string {
-	minus := ""
-	if money < 0 {
-		minus = "ลบ"
+	text, err := WordsE(money)
+	if err != nil {
+		panic(err)
+	}
+	return text
+}
+
+// WordsE is like Words but returns an error instead of producing undefined
+// text for NaN, ±Inf, and amounts beyond ±2^53, where float64 can no longer
+// represent every whole baht. The error wraps ErrNotFinite or ErrRange. Larger
+// amounts can be converted exactly with WordsBigInt or WordsFromStringStrict.
+//
+// Example usage:
+//
+//	text, err := baht.WordsE(amount)
+//	if err != nil {
+//		return err
+//	}
+func WordsE(money float64) (string, error) {
+	return std.WordsE(money)
+}
+
+// checkAmount reports ErrNotFinite or ErrRange for amounts WordsE rejects.
+func checkAmount(money float64) error {
+	if math.IsNaN(money) || math.IsInf(money, 0) {
+		return ErrNotFinite
 	}
+	if math.Abs(money) > maxExactAmount {
+		return ErrRange
+	}
+	return nil
+}
 
-	preciseAmount := math.Round(math.Abs(money)*100) / 100
-	wholeBaht := math.Trunc(preciseAmount)
-	satang := math.Round((preciseAmount - wholeBaht) * 100)
+// WordsSatang converts an amount expressed in satang (1/100 baht) into its Thai
+// word representation. It never goes through float64, so every int64 value,
+// including math.MinInt64, is converted exactly.
+//
+// Example usage:
+//
+//	text := baht.WordsSatang(123456)
+//	fmt.Println(text) // Output: หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์
+func WordsSatang(satang int64) string {
+	return must(std.WordsSatang(satang))
+}
 
-	bahtText := moneyToThaiWords(uint64(wholeBaht))
+// WordsScaled converts a fixed-point amount into its Thai word representation.
+// The amount is value / 10^scale baht, so scale 2 is satang and scale 4 is the
+// OLE/Excel CURRENCY type. Fractions finer than a satang are rounded half away
+// from zero, the same way Words rounds.
+// It panics if scale is outside the range 0 to 19.
+//
+// Example usage:
+//
+//	text := baht.WordsScaled(12345678, 4)
+//	fmt.Println(text) // Output: หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบเจ็ดสตางค์
+func WordsScaled(value int64, scale int) string {
+	return must(std.WordsScaled(value, scale))
+}
 
-	if satang == 0 {
-		return minus + bahtText + "บาทถ้วน"
+// pow10 holds the powers of ten that fit in a uint64.
+var pow10 = func() []uint64 {
+	p := []uint64{1}
+	for i := 1; i < 20; i++ {
+		p = append(p, p[i-1]*10)
 	}
+	return p
+}()
 
-	satangText := moneyToThaiWords(uint64(satang))
-	return fmt.Sprintf("%s%s%s%s%s", minus, bahtText, "บาท", satangText, "สตางค์")
+// absInt64 returns the magnitude of n as a uint64, which unlike int64 can hold
+// the magnitude of math.MinInt64.
+func absInt64(n int64) uint64 {
+	if n < 0 {
+		return uint64(-(n + 1)) + 1
+	}
+	return uint64(n)
 }
 
 // moneyToThaiWords converts an integer to its Thai word representation.
 // This is a helper function to be used internally.
 func moneyToThaiWords(m uint64) string {
-	if m == 0 {
-		return "ศูนย์"
-	}
+	return std.cardinal(strconv.FormatUint(m, 10))
+}
 
-	var result strings.Builder
+// thaiScript holds the words the Thai reading is built from, so that the same
+// rules write Thai script, its RTGS romanization and Lao.
+type thaiScript struct {
+	digits []string // the words for one to nine, indexed by digit
+	places []string // the words for สิบ to ล้าน, indexed by place
+	twenty []string // the words for two in the tens place, as in ยี่สิบ
+	et     string   // a trailing one after other words, as in สิบเอ็ด
+	units  Units
+	// tenThousands reads the ten thousands as tens of thousands, as in Lao
+	// ສິບພັນ, instead of with their own place word, as in Thai หนึ่งหมื่น
+	tenThousands bool
+}
+
+// thai is the Thai script reading.
+var thai = thaiScript{
+	digits: unitWords,
+	places: unitPlaces,
+	twenty: []string{"ยี่", "สิบ"},
+	et:     "เอ็ด",
+	units: Units{
+		Sign:       "ลบ",
+		Major:      "บาท",
+		Minor:      "สตางค์",
+		Exact:      "ถ้วน",
+		Zero:       "ศูนย์",
+		Point:      "จุด",
+		Annotation: "(ขาดทุน)",
+	},
+}
 
-	// Handle millions (ล้าน)
-	if m >= 1000000 {
-		millionPart := m / 1000000
-		m %= 1000000
-		result.WriteString(moneyToThaiWords(millionPart) + unitPlaces[6])
+// thaiSpeller is the Speller of the Thai place system, which reads six digits
+// at a time chained with "ล้าน".
+type thaiSpeller struct {
+	c      *Converter
+	script *thaiScript
+}
+
+func (s thaiSpeller) GroupSize() int { return 6 }
+
+func (s thaiSpeller) SpellDigit(digit int) string { return s.script.digits[digit] }
+
+func (s thaiSpeller) Units() Units { return s.script.units }
+
+// JoinScale appends "ล้าน", which Thai names even after a group of zeros, as
+// in หนึ่งล้านล้าน.
+func (s thaiSpeller) JoinScale(words []string, index int, zero bool) []string {
+	return append(words, s.script.places[6])
+}
+
+func (s thaiSpeller) scaleTokens(index int, zero bool) []Token {
+	return []Token{{Text: s.script.places[6], Kind: TokenScale, Digit: -1, Position: 6 * index}}
+}
+
+func (s thaiSpeller) SpellGroup(words []string, group string, index int, skipped bool) []string {
+	for _, t := range s.groupTokens(group, index, len(words) > 0, skipped) {
+		words = append(words, t.Text)
 	}
+	return words
+}
 
-	s := fmt.Sprintf("%d", m)
-	lenS := len(s)
+func (s thaiSpeller) groupTokens(group string, index int, started, skipped bool) []Token {
+	script := s.script
+	lenS := len(group)
 
-	for i, char := range s {
+	var tokens []Token
+	for i, char := range group {
 		digit := int(char - '0')
 		if digit == 0 {
 			continue
@@ -73,49 +191,71 @@ func moneyToThaiWords(m uint64) string {
 
 		place := lenS - i - 1
 		isLast := place == 0
-
-		// Special case for "ยี่สิบ"
-		if place == 1 && digit == 2 {
-			result.WriteString("ยี่สิบ")
-			continue
+		spoken := started || len(tokens) > 0
+		add := func(text string, kind TokenKind) {
+			tokens = append(tokens, Token{Text: text, Kind: kind, Digit: digit, Position: 6*index + place})
 		}
 
-		// Special case for "เอ็ด"
-		if isLast && digit == 1 && result.Len() > 0 {
-			result.WriteString("เอ็ด")
+		// Special cases for the tens: "ยี่สิบ", and "สิบ", never "หนึ่งสิบ".
+		// Scripts with tenThousands read the ten thousands the same way,
+		// followed by the thousands word if no thousands digit does it.
+		if place == 1 || script.tenThousands && place == 4 {
+			switch digit {
+			case 1:
+				add(script.places[1], TokenPlace)
+			case 2:
+				for j, text := range script.twenty {
+					if j == 0 {
+						add(text, TokenSpecial)
+					} else {
+						add(text, TokenPlace)
+					}
+				}
+			default:
+				add(script.digits[digit], TokenDigit)
+				add(script.places[1], TokenPlace)
+			}
+			if place == 4 && group[i+1] == '0' {
+				add(script.places[3], TokenPlace)
+			}
 			continue
 		}
 
-		// Special case for "สิบ" when digit is 1 and place is 1
-		if place == 1 && digit == 1 && result.Len() == 0 {
-			result.WriteString("สิบ")
+		// Special case for "เอ็ด", which the Royal Institute style only
+		// uses after a non-zero tens digit. Scripts with tenThousands also
+		// use it for the thousands, always after a non-zero tens digit.
+		tensIsZero := i == 0 || group[i-1] == '0'
+		if digit == 1 && spoken && (isLast && !(s.c.style == RoyalInstitute && tensIsZero) ||
+			script.tenThousands && place == 3 && !tensIsZero) {
+			add(script.et, TokenSpecial)
+			if place > 0 {
+				add(script.places[place], TokenPlace)
+			}
 			continue
 		}
 
-		// Skip "หนึ่ง" for tens place when there are higher places
-		if place == 1 && digit == 1 && result.Len() > 0 {
-			if place < len(unitPlaces) {
-				result.WriteString(unitPlaces[place])
+		// Leading "หนึ่ง" before a place name or ล้าน, dropped on request
+		if digit == 1 && !spoken && s.c.omitLeadingOne && (place > 0 || index > 0) {
+			if place > 0 {
+				add(script.places[place], TokenPlace)
 			}
 			continue
 		}
 
-		if digit >= 0 && digit < len(unitWords) {
-			result.WriteString(unitWords[digit])
-		}
+		add(script.digits[digit], TokenDigit)
 
 		// Add unit places
-		if place > 0 && place < len(unitPlaces) {
-			result.WriteString(unitPlaces[place])
+		if place > 0 {
+			add(script.places[place], TokenPlace)
 		}
 	}
-
-	return result.String()
+	return tokens
 }
 
 // WordsFromString converts a string amount into its Thai word representation.
 // It parses the string as a float64 and then converts it to Thai words.
-// Returns the converted string and an error if the string cannot be parsed.
+// Returns the converted string and a *ParseError if the string cannot be parsed
+// or the amount is rejected by WordsE, such as "NaN", "Inf" or "1e400".
 //
 // Example usage:
 //
@@ -134,16 +274,39 @@ func moneyToThaiWords(m uint64) string {
 //   - Comma-separated with decimals: WordsFromString("1,234.56") -> "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์", nil
 //   - Comma-separated Large Number: WordsFromString("1,234,567,890") -> "หนึ่งพันสองร้อยสามสิบสี่ล้านห้าแสนหกหมื่นเจ็ดพันแปดร้อยเก้าสิบบาทถ้วน", nil
 func WordsFromString(money string) (string, error) {
+	return std.WordsFromString(money)
+}
+
+// parseLenient parses money the way WordsFromString does, ignoring commas and
+// surrounding white space, and checks the amount as WordsE would.
+func parseLenient(money string) (float64, error) {
 	// Remove commas and trim whitespace
 	cleanMoney := strings.ReplaceAll(strings.TrimSpace(money), ",", "")
+	if cleanMoney == "" {
+		return 0, &ParseError{Input: money, Offset: 0, Err: ErrEmpty}
+	}
+
 	amount, err := strconv.ParseFloat(cleanMoney, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, &ParseError{Input: money, Offset: leadingSpace(money), Err: ErrRange, Cause: err}
+	}
 	if err != nil {
-		return "", fmt.Errorf("invalid number format: %s", money)
+		return 0, &ParseError{Input: money, Offset: syntaxOffset(money), Err: ErrSyntax, Cause: err}
+	}
+	if err := checkAmount(amount); err != nil {
+		return 0, &ParseError{Input: money, Offset: leadingSpace(money), Err: err}
+	}
+	// Inputs just beyond 2^53, such as 9007199254740993, round onto it
+	if math.Abs(amount) == maxExactAmount {
+		if exact, ok := new(big.Rat).SetString(cleanMoney); ok && exact.Cmp(new(big.Rat).SetFloat64(amount)) != 0 {
+			return 0, &ParseError{Input: money, Offset: leadingSpace(money), Err: ErrRange}
+		}
 	}
-	return Words(amount), nil
+
+	return amount, nil
 }
 
-// MustWordsFromString is like TextFromString but panics if the string cannot be parsed.
+// MustWordsFromString is like WordsFromString but panics with the *ParseError if the string cannot be parsed.
 // Use this when you are certain the string is a valid number.
 //
 // Example usage:
@@ -158,3 +321,12 @@ func MustWordsFromString(money string) string {
 	}
 	return text
 }
+
+// must returns text, panicking if err is not nil. It backs the package-level
+// functions whose default conversion can only fail on programmer error.
+func must(text string, err error) string {
+	if err != nil {
+		panic(err)
+	}
+	return text
+}
@@ -1,6 +1,9 @@
 package bahttext
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func TestBahtWords(t *testing.T) {
 	tests := []struct {
@@ -148,6 +151,63 @@ func TestBahtWords(t *testing.T) {
 	}
 }
 
+func TestWordsE(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   float64
+		want    string
+		wantErr bool
+	}{
+		{"zero", 0, "ศูนย์บาทถ้วน", false},
+		{"decimal", 1234.56, "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์", false},
+		{"negative", -100, "ลบหนึ่งร้อยบาทถ้วน", false},
+		{"max-exact", 1 << 53, "เก้าพันเจ็ดล้านหนึ่งแสนเก้าหมื่นเก้าพันสองร้อยห้าสิบสี่ล้านเจ็ดแสนสี่หมื่นเก้าร้อยเก้าสิบสองบาทถ้วน", false},
+		{"min-exact", -(1 << 53), "ลบเก้าพันเจ็ดล้านหนึ่งแสนเก้าหมื่นเก้าพันสองร้อยห้าสิบสี่ล้านเจ็ดแสนสี่หมื่นเก้าร้อยเก้าสิบสองบาทถ้วน", false},
+
+		{"nan", math.NaN(), "", true},
+		{"positive-inf", math.Inf(1), "", true},
+		{"negative-inf", math.Inf(-1), "", true},
+		{"beyond-exact", 1<<53 + 2, "", true},
+		{"ten-quadrillion", 1e16, "", true},
+		{"ten-quintillion", 1e19, "", true},
+		{"two-to-the-sixty", 1 << 60, "", true},
+		{"uint64-range", 1 << 64, "", true},
+		{"beyond-uint64", 1e20, "", true},
+		{"max-float", -math.MaxFloat64, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := WordsE(tt.input)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("WordsE(%v) expected error, got %s", tt.input, result)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("WordsE(%v) unexpected error: %v", tt.input, err)
+				return
+			}
+
+			if result != tt.want {
+				t.Errorf("WordsE(%v) = %s, want %s", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	t.Run("words-panics-on-nan", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("Words should panic on NaN")
+			}
+		}()
+		Words(math.NaN())
+	})
+}
+
 func TestWordsFromString(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -178,6 +238,16 @@ func TestWordsFromString(t *testing.T) {
 		{"mixed-text", "123abc", "", true},
 		{"multiple-dots", "12.34.56", "", true},
 		{"special-chars", "12@34", "", true},
+		{"nan", "NaN", "", true},
+		{"inf", "Inf", "", true},
+		{"negative-infinity", "-Infinity", "", true},
+		{"overflow", "1e400", "", true},
+		{"beyond-exact-range", "1e20", "", true},
+		{"beyond-exact-scientific", "1e16", "", true},
+		{"max-exact-digits", "9007199254740992", "เก้าพันเจ็ดล้านหนึ่งแสนเก้าหมื่นเก้าพันสองร้อยห้าสิบสี่ล้านเจ็ดแสนสี่หมื่นเก้าร้อยเก้าสิบสองบาทถ้วน", false},
+		{"beyond-exact-digits", "9007199254740993", "", true},
+		{"beyond-exact-fraction", "9007199254740991.9", "", true},
+		{"beyond-exact-long", "1234567890123456789", "", true},
 	}
 
 	for _, tt := range tests {
@@ -234,3 +304,83 @@ func TestMustWordsFromString(t *testing.T) {
 		MustWordsFromString("invalid")
 	})
 }
+
+func TestWordsSatang(t *testing.T) {
+	tests := []struct {
+		name  string
+		input int64
+		want  string
+	}{
+		{"zero", 0, "ศูนย์บาทถ้วน"},
+		{"one-satang", 1, "ศูนย์บาทหนึ่งสตางค์"},
+		{"fifty-satang", 50, "ศูนย์บาทห้าสิบสตางค์"},
+		{"one-baht", 100, "หนึ่งบาทถ้วน"},
+		{"one-baht-one-satang", 101, "หนึ่งบาทหนึ่งสตางค์"},
+		{"decimal", 123456, "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์"},
+		{"negative", -10050, "ลบหนึ่งร้อยบาทห้าสิบสตางค์"},
+		{"max-int64", math.MaxInt64, "เก้าหมื่นสองพันสองร้อยสามสิบสามล้านเจ็ดแสนสองหมื่นสามร้อยหกสิบแปดล้านห้าแสนสี่หมื่นเจ็ดพันเจ็ดร้อยห้าสิบแปดบาทเจ็ดสตางค์"},
+		{"min-int64", math.MinInt64, "ลบเก้าหมื่นสองพันสองร้อยสามสิบสามล้านเจ็ดแสนสองหมื่นสามร้อยหกสิบแปดล้านห้าแสนสี่หมื่นเจ็ดพันเจ็ดร้อยห้าสิบแปดบาทแปดสตางค์"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := WordsSatang(tt.input)
+			if result != tt.want {
+				t.Errorf("WordsSatang(%d) = %s, want %s", tt.input, result, tt.want)
+			}
+		})
+	}
+}
+
+func TestWordsSatangMatchesWords(t *testing.T) {
+	inputs := []int64{0, 1, 99, 100, 101, 2_100, 123_456, -5_199, -5_200, 100_000_001, 123_456_789_012_34}
+	for s := int64(-20_000); s <= 20_000; s += 7 {
+		inputs = append(inputs, s)
+	}
+
+	for _, satang := range inputs {
+		want := Words(float64(satang) / 100)
+		if got := WordsSatang(satang); got != want {
+			t.Errorf("WordsSatang(%d) = %s, Words(%v) = %s", satang, got, float64(satang)/100, want)
+		}
+	}
+}
+
+func TestWordsScaled(t *testing.T) {
+	tests := []struct {
+		name  string
+		value int64
+		scale int
+		want  string
+	}{
+		{"whole-baht", 1234, 0, "หนึ่งพันสองร้อยสามสิบสี่บาทถ้วน"},
+		{"one-decimal", 105, 1, "สิบบาทห้าสิบสตางค์"},
+		{"satang", 123456, 2, "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์"},
+		{"currency-scale", 12345678, 4, "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบเจ็ดสตางค์"},
+		{"currency-scale-round-down", 12345649, 4, "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์"},
+		{"currency-scale-half", 12345650, 4, "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบเจ็ดสตางค์"},
+		{"carry-into-baht", 19950, 4, "สองบาทถ้วน"},
+		{"negative-half", -519950, 4, "ลบห้าสิบสองบาทถ้วน"},
+		{"negative-rounds-to-zero", -1, 4, "ลบศูนย์บาทถ้วน"},
+		{"max-scale", math.MaxInt64, 19, "ศูนย์บาทเก้าสิบสองสตางค์"},
+		{"min-int64", math.MinInt64, 0, "ลบเก้าล้านสองแสนสองหมื่นสามพันสามร้อยเจ็ดสิบสองล้านสามหมื่นหกพันแปดร้อยห้าสิบสี่ล้านเจ็ดแสนเจ็ดหมื่นห้าพันแปดร้อยแปดบาทถ้วน"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := WordsScaled(tt.value, tt.scale)
+			if result != tt.want {
+				t.Errorf("WordsScaled(%d, %d) = %s, want %s", tt.value, tt.scale, result, tt.want)
+			}
+		})
+	}
+
+	t.Run("panic-on-invalid-scale", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("WordsScaled should panic on a negative scale")
+			}
+		}()
+		WordsScaled(1, -1)
+	})
+}
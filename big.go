@@ -0,0 +1,34 @@
+package bahttext
+
+import "math/big"
+
+var (
+	bigOne     = big.NewInt(1)
+	bigHundred = big.NewInt(100)
+)
+
+// WordsBigInt converts a whole-baht amount of any size into its Thai word
+// representation. Amounts beyond a million million keep chaining "ล้าน", so
+// 10^18 reads "หนึ่งล้านล้านล้านบาทถ้วน".
+//
+// Example usage:
+//
+//	n, _ := new(big.Int).SetString("1234567890123456789", 10)
+//	text := baht.WordsBigInt(n)
+//	fmt.Println(text) // Output: หนึ่งล้านสองแสนสามหมื่นสี่พันห้าร้อยหกสิบเจ็ดล้านแปดแสนเก้าหมื่นหนึ่งร้อยยี่สิบสามล้านสี่แสนห้าหมื่นหกพันเจ็ดร้อยแปดสิบเก้าบาทถ้วน
+func WordsBigInt(baht *big.Int) string {
+	return must(std.WordsBigInt(baht))
+}
+
+// WordsBigRat converts an exact rational amount of any size into its Thai word
+// representation. Fractions finer than a satang are rounded half away from
+// zero, the same way Words rounds, but without any float64 error.
+//
+// Example usage:
+//
+//	r, _ := new(big.Rat).SetString("1234.565")
+//	text := baht.WordsBigRat(r)
+//	fmt.Println(text) // Output: หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบเจ็ดสตางค์
+func WordsBigRat(money *big.Rat) string {
+	return must(std.WordsBigRat(money))
+}
@@ -0,0 +1,80 @@
+package bahttext
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestWordsBigInt(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"zero", "0", "ศูนย์บาทถ้วน"},
+		{"one-million", "1000000", "หนึ่งล้านบาทถ้วน"},
+		{"ten-million-one", "10000001", "สิบล้านเอ็ดบาทถ้วน"},
+		{"negative", "-100", "ลบหนึ่งร้อยบาทถ้วน"},
+		{"readme-example", "1234567890123456789", "หนึ่งล้านสองแสนสามหมื่นสี่พันห้าร้อยหกสิบเจ็ดล้านแปดแสนเก้าหมื่นหนึ่งร้อยยี่สิบสามล้านสี่แสนห้าหมื่นหกพันเจ็ดร้อยแปดสิบเก้าบาทถ้วน"},
+		{"max-uint64", "18446744073709551615", "สิบแปดล้านสี่แสนสี่หมื่นหกพันเจ็ดร้อยสี่สิบสี่ล้านเจ็ดหมื่นสามพันเจ็ดร้อยเก้าล้านห้าแสนห้าหมื่นหนึ่งพันหกร้อยสิบห้าบาทถ้วน"},
+		{"beyond-uint64", "18446744073709551616", "สิบแปดล้านสี่แสนสี่หมื่นหกพันเจ็ดร้อยสี่สิบสี่ล้านเจ็ดหมื่นสามพันเจ็ดร้อยเก้าล้านห้าแสนห้าหมื่นหนึ่งพันหกร้อยสิบหกบาทถ้วน"},
+		{"million-cubed", "1000000000000000000", "หนึ่งล้านล้านล้านบาทถ้วน"},
+		{"million-cubed-one", "1000000000000000001", "หนึ่งล้านล้านล้านเอ็ดบาทถ้วน"},
+		{"million-to-the-fifth", "1000000000000000000000000000000", "หนึ่งล้านล้านล้านล้านล้านบาทถ้วน"},
+		{"negative-huge", "-21000000000000000000", "ลบยี่สิบเอ็ดล้านล้านล้านบาทถ้วน"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, ok := new(big.Int).SetString(tt.input, 10)
+			if !ok {
+				t.Fatalf("invalid test input %q", tt.input)
+			}
+			result := WordsBigInt(n)
+			if result != tt.want {
+				t.Errorf("WordsBigInt(%s) = %s, want %s", tt.input, result, tt.want)
+			}
+		})
+	}
+}
+
+func TestWordsBigIntMatchesUint64(t *testing.T) {
+	inputs := []uint64{0, 1, 11, 21, 101, 1_000_001, 10_000_000, 1_000_001_000_000, math.MaxInt64, math.MaxUint64}
+	for _, n := range inputs {
+		want := moneyToThaiWords(n) + "บาทถ้วน"
+		if got := WordsBigInt(new(big.Int).SetUint64(n)); got != want {
+			t.Errorf("WordsBigInt(%d) = %s, want %s", n, got, want)
+		}
+	}
+}
+
+func TestWordsBigRat(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"zero", "0", "ศูนย์บาทถ้วน"},
+		{"decimal", "1234.56", "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์"},
+		{"half-rounds-up", "1234.565", "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบเจ็ดสตางค์"},
+		{"below-half-rounds-down", "1234.5649999", "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์"},
+		{"fraction", "1/3", "ศูนย์บาทสามสิบสามสตางค์"},
+		{"carry-into-baht", "1.995", "สองบาทถ้วน"},
+		{"negative-half", "-51.995", "ลบห้าสิบสองบาทถ้วน"},
+		{"huge", "1000000000000000000000000.01", "หนึ่งล้านล้านล้านล้านบาทหนึ่งสตางค์"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, ok := new(big.Rat).SetString(tt.input)
+			if !ok {
+				t.Fatalf("invalid test input %q", tt.input)
+			}
+			result := WordsBigRat(r)
+			if result != tt.want {
+				t.Errorf("WordsBigRat(%s) = %s, want %s", tt.input, result, tt.want)
+			}
+		})
+	}
+}
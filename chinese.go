@@ -0,0 +1,131 @@
+package bahttext
+
+import (
+	"strconv"
+	"strings"
+)
+
+var (
+	chineseDigits = []string{"零", "壹", "贰", "叁", "肆", "伍", "陆", "柒", "捌", "玖"}
+	chinesePlaces = []string{"", "拾", "佰", "仟"}
+	// chineseMinor names the fraction digits, from tenths down
+	chineseMinor = []string{"角", "分", "厘", "毫"}
+)
+
+// chineseUnits are the words of Chinese readings. The major unit is the
+// currency name written before the amount.
+var chineseUnits = Units{
+	Sign:       "负",
+	Major:      "泰铢",
+	Minor:      "分",
+	Exact:      "整",
+	Zero:       "零",
+	Point:      "点",
+	Annotation: "(亏损)",
+}
+
+// chineseSpeller is the Speller of Chinese financial numerals. It groups
+// digits by 万 (10^4) rather than by ล้าน, naming larger groups 亿, 万亿, 亿亿
+// and so on, and writes a single 零 for each run of zeros followed by a
+// non-zero digit.
+type chineseSpeller struct {
+	c *Converter
+}
+
+func (s chineseSpeller) GroupSize() int { return 4 }
+
+func (s chineseSpeller) SpellDigit(digit int) string { return chineseDigits[digit] }
+
+func (s chineseSpeller) Units() Units { return chineseUnits }
+
+func (s chineseSpeller) JoinScale(words []string, index int, zero bool) []string {
+	for _, t := range s.scaleTokens(index, zero) {
+		words = append(words, t.Text)
+	}
+	return words
+}
+
+func (s chineseSpeller) scaleTokens(index int, zero bool) []Token {
+	if zero {
+		return nil
+	}
+	return []Token{{Text: chineseScale(index), Kind: TokenScale, Digit: -1, Position: 4 * index}}
+}
+
+func (s chineseSpeller) SpellGroup(words []string, group string, index int, skipped bool) []string {
+	for _, t := range s.groupTokens(group, index, len(words) > 0, skipped) {
+		words = append(words, t.Text)
+	}
+	return words
+}
+
+func (s chineseSpeller) groupTokens(group string, index int, started, skipped bool) []Token {
+	var tokens []Token
+	// A run of zeros awaits its 零, which takes the position of the last
+	// zero; zeros ending a group read nothing
+	zero := skipped
+	for j, char := range group {
+		digit := int(char - '0')
+		position := 4*index + 3 - j
+		if digit == 0 {
+			zero = zero || started || len(tokens) > 0
+			continue
+		}
+		if zero {
+			tokens = append(tokens, Token{Text: s.c.zero, Kind: TokenDigit, Position: position + 1})
+			zero = false
+		}
+		tokens = append(tokens, Token{Text: chineseDigits[digit], Kind: TokenDigit, Digit: digit, Position: position})
+		if place := chinesePlaces[3-j]; place != "" {
+			tokens = append(tokens, Token{Text: place, Kind: TokenPlace, Digit: digit, Position: position})
+		}
+	}
+	return tokens
+}
+
+// spellAmount assembles Chinese amounts. The currency name comes first and
+// the amount is read in 元, so 1234.56 reads "泰铢壹仟贰佰叁拾肆元伍角陆分". A
+// whole amount ends with 整, and an amount below one 元 is read in its minor
+// units alone, as on Chinese invoices.
+func (s chineseSpeller) spellAmount(noBaht bool, baht string, satang uint64) []Token {
+	c := s.c
+	tokens := []Token{word(c.baht, TokenUnit)}
+
+	if !noBaht || satang == 0 {
+		tokens = append(tokens, c.cardinalTokens(baht, 0)...)
+		tokens = append(tokens, word("元", TokenUnit))
+	}
+	if satang == 0 {
+		return append(tokens, word(c.exact, TokenSuffix))
+	}
+
+	// A zero between the 元 and the last non-zero minor digit reads 零 once
+	minor := strconv.FormatUint(satang, 10)
+	minor = strings.Repeat("0", c.exponent-len(minor)) + strings.TrimRight(minor, "0")
+	zero := false
+	for i, char := range minor {
+		digit := int(char - '0')
+		if digit == 0 {
+			zero = zero || !noBaht || i > 0
+			continue
+		}
+		if zero {
+			tokens = append(tokens, Token{Text: c.zero, Kind: TokenDigit, Position: -i})
+			zero = false
+		}
+		position := -i - 1
+		tokens = append(tokens, Token{Text: chineseDigits[digit], Kind: TokenDigit, Digit: digit, Position: position},
+			Token{Text: chineseMinor[i], Kind: TokenUnit, Digit: digit, Position: position})
+	}
+	return tokens
+}
+
+// chineseScale names the group of four digits with the given index counted
+// from the right: "" for units, then 万, 亿, 万亿, 亿亿 and so on.
+func chineseScale(index int) string {
+	name := strings.Repeat("亿", index/2)
+	if index%2 == 1 {
+		name = "万" + name
+	}
+	return name
+}
@@ -0,0 +1,109 @@
+package bahttext
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestChineseWords(t *testing.T) {
+	c, err := New(WithLanguage(Chinese))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		input float64
+		want  string
+	}{
+		{"zero", 0, "泰铢零元整"},
+		{"example", 1234.56, "泰铢壹仟贰佰叁拾肆元伍角陆分"},
+		{"ten", 10, "泰铢壹拾元整"},
+		{"thousand-one", 1001, "泰铢壹仟零壹元整"},
+		{"thousand-ten", 1010, "泰铢壹仟零壹拾元整"},
+		{"thousand-one-hundred", 1100, "泰铢壹仟壹佰元整"},
+		{"wan-zero", 10010, "泰铢壹万零壹拾元整"},
+		{"shi-wan", 100000, "泰铢壹拾万元整"},
+		{"bai-wan-one", 1_000_001, "泰铢壹佰万零壹元整"},
+		{"group-trailing-zeros", 10_001_000, "泰铢壹仟万壹仟元整"},
+		{"group-leading-zero", 10_000_100, "泰铢壹仟万零壹佰元整"},
+		{"wan", 12_345_678.9, "泰铢壹仟贰佰叁拾肆万伍仟陆佰柒拾捌元玖角"},
+		{"yi-one", 100_000_001, "泰铢壹亿零壹元整"},
+		{"yi-wan", 100_010_000, "泰铢壹亿零壹万元整"},
+		{"wan-yi", 1e12, "泰铢壹万亿元整"},
+		{"zero-jiao", 1.05, "泰铢壹元零伍分"},
+		{"ten-zero-jiao", 10.05, "泰铢壹拾元零伍分"},
+		{"jiao-only", 10.5, "泰铢壹拾元伍角"},
+		{"below-one", 0.56, "泰铢伍角陆分"},
+		{"fen-only", 0.05, "泰铢伍分"},
+		{"yi-fen", 200_000_000.02, "泰铢贰亿元零贰分"},
+		{"negative", -3, "负泰铢叁元整"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.Words(tt.input); got != tt.want {
+				t.Errorf("Chinese Words(%v) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChineseLargeNumbers(t *testing.T) {
+	c, err := New(WithLanguage(Chinese))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"10000000000000000", "泰铢壹亿亿元整"},
+		{"10000000100000000", "泰铢壹亿亿零壹亿元整"},
+		{"123456789012345678", "泰铢壹拾贰亿亿叁仟肆佰伍拾陆万亿柒仟捌佰玖拾亿壹仟贰佰叁拾肆万伍仟陆佰柒拾捌元整"},
+	}
+
+	for _, tt := range tests {
+		n, _ := new(big.Int).SetString(tt.input, 10)
+		got, err := c.WordsBigInt(n)
+		if err != nil {
+			t.Fatalf("WordsBigInt(%s) unexpected error: %v", tt.input, err)
+		}
+		if got != tt.want {
+			t.Errorf("Chinese WordsBigInt(%s) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestChineseCurrency(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  []Option
+		input float64
+		want  string
+	}{
+		{"currency-name", []Option{WithMajorUnit("人民币")}, 1.5, "人民币壹元伍角"},
+		{"parentheses", []Option{WithNegativeStyle(NegativeParentheses, NegativeZeroSigned)}, -1, "(泰铢壹元整)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(append([]Option{WithLanguage(Chinese)}, tt.opts...)...)
+			if err != nil {
+				t.Fatalf("New() unexpected error: %v", err)
+			}
+			if got := c.Words(tt.input); got != tt.want {
+				t.Errorf("Chinese Words(%v) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	c, err := New(WithLanguage(Chinese))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	if got, want := c.Number(-1020), "负壹仟零贰拾"; got != want {
+		t.Errorf("Chinese Number(-1020) = %s, want %s", got, want)
+	}
+}
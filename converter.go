@@ -0,0 +1,351 @@
+package bahttext
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Converter converts amounts into Thai words using a fixed set of options.
+// It is immutable once built by New, so a single Converter is safe for
+// concurrent use. The package-level functions use a Converter with the
+// default options.
+//
+// The zero value is ready to use and reads like the package-level functions.
+type Converter struct {
+	minus    string // word before negative amounts
+	baht     string // word after the whole baht
+	exact    string // word ending amounts without satang
+	satang   string // word after the satang
+	exponent int    // number of satang digits, 2 unless set by WithCurrency
+	currency string // ISO 4217 code set by WithCurrency
+	point    string // word for the decimal point in Decimal
+	zero     string // word for zero
+	rounding RoundingMode
+	excel    bool // match Excel's BAHTTEXT() for amounts below one baht
+	negative NegativeStyle
+	// negativeZero decides the sign of negative amounts that round to zero
+	negativeZero NegativeZero
+	annotation   string // written after negative amounts by NegativeAnnotation
+	style        ReadingStyle
+	// omitLeadingOne drops "หนึ่ง" before the place name that starts a reading
+	omitLeadingOne bool
+	language       Language
+	custom         Speller     // set by WithSpeller, overriding language
+	vocabulary     *thaiScript // set by WithDialect and WithVocabulary
+	english        englishFormat
+	rtgs           rtgsFormat
+}
+
+// Option configures a Converter built with New.
+type Option func(*Converter) error
+
+// roundFloat is the historical rounding of Words: math.Round on the float64
+// amount times 100. It is the default and cannot be selected with WithRounding.
+const roundFloat RoundingMode = -1
+
+// std is the Converter behind the package-level functions.
+var std = &Converter{
+	minus:      "ลบ",
+	baht:       "บาท",
+	exact:      "ถ้วน",
+	satang:     "สตางค์",
+	exponent:   2,
+	point:      "จุด",
+	zero:       "ศูนย์",
+	rounding:   roundFloat,
+	annotation: "(ขาดทุน)",
+	english:    englishFormat{and: true, hyphen: true},
+	rtgs:       rtgsFormat{separator: " ", capitalization: CapitalizeLower},
+}
+
+// ready returns c, or std if c is the zero Converter, which has no words.
+// Every exported method goes through it so the zero value reads like std.
+func (c *Converter) ready() *Converter {
+	if c.baht == "" {
+		return std
+	}
+	return c
+}
+
+// New returns a Converter with the default options, as used by the
+// package-level functions, changed by opts. It returns the error of the first
+// option that fails.
+//
+// Example usage:
+//
+//	c, err := baht.New(baht.WithRounding(baht.RoundHalfEven))
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(c.Words(1.005)) // Output: หนึ่งบาทถ้วน
+func New(opts ...Option) (*Converter, error) {
+	return std.With(opts...)
+}
+
+// WithRounding makes the Converter round fractions finer than a satang with
+// mode, as described at WordsRounded. It applies to every method that may
+// need rounding, including WordsScaled and WordsBigRat.
+func WithRounding(mode RoundingMode) Option {
+	return func(c *Converter) error {
+		if err := mode.valid(); err != nil {
+			return err
+		}
+		c.rounding = mode
+		return nil
+	}
+}
+
+// ExcelCompatible makes the Converter match Microsoft Excel's BAHTTEXT()
+// byte for byte. It differs from the default output in two cases:
+//
+//   - amounts below one baht are read in satang alone: 0.5 reads
+//     "ห้าสิบสตางค์" instead of "ศูนย์บาทห้าสิบสตางค์";
+//   - negative amounts that round to zero lose their sign: -0.001 reads
+//     "ศูนย์บาทถ้วน" instead of "ลบศูนย์บาทถ้วน", as with NegativeZeroUnsigned.
+func ExcelCompatible() Option {
+	return func(c *Converter) error {
+		c.excel = true
+		c.negativeZero = NegativeZeroUnsigned
+		return nil
+	}
+}
+
+// WithReadingStyle makes the Converter read numbers in the given style.
+func WithReadingStyle(style ReadingStyle) Option {
+	return func(c *Converter) error {
+		if style != CommonStyle && style != RoyalInstitute {
+			return fmt.Errorf("invalid reading style %d", int(style))
+		}
+		c.style = style
+		return nil
+	}
+}
+
+// OmitLeadingOne makes the Converter drop the "หนึ่ง" that would start a
+// reading before a place name, as in spoken Thai: 100 reads "ร้อยบาทถ้วน",
+// 1500 "พันห้าร้อยบาทถ้วน" and 1,000,000 "ล้านบาทถ้วน". Only the first word
+// of the leading group is dropped, so a chained "ล้าน" reads "ล้านล้าน" for
+// 10^12 while 1,100 still reads "พันหนึ่งร้อย". A lone one still reads "หนึ่ง".
+func OmitLeadingOne() Option {
+	return func(c *Converter) error {
+		c.omitLeadingOne = true
+		return nil
+	}
+}
+
+// Words is like the package-level Words but uses c's options.
+func (c *Converter) Words(money float64) string {
+	return must(c.WordsE(money))
+}
+
+// WordsE is like the package-level WordsE but uses c's options.
+func (c *Converter) WordsE(money float64) (string, error) {
+	c = c.ready()
+	negative, baht, satang, err := c.split(money)
+	if err != nil {
+		return "", err
+	}
+	return c.bahtWords(negative, baht, satang)
+}
+
+// split rounds money with c's options and splits it into its sign, whole
+// baht, given as decimal digits, and satang.
+func (c *Converter) split(money float64) (negative bool, baht string, satang uint64, err error) {
+	if err := checkAmount(money); err != nil {
+		return false, "", 0, fmt.Errorf("%w: %v", err, money)
+	}
+
+	if c.rounding == roundFloat {
+		scale := float64(pow10[c.exponent])
+		preciseAmount := math.Round(math.Abs(money)*scale) / scale
+		wholeBaht := math.Trunc(preciseAmount)
+		satang := math.Round((preciseAmount - wholeBaht) * scale)
+
+		return money < 0, strconv.FormatUint(uint64(wholeBaht), 10), uint64(satang), nil
+	}
+
+	d, err := floatDecimal(money).round(c.exponent, c.rounding)
+	if err != nil {
+		return false, "", 0, fmt.Errorf("%w: %v", err, money)
+	}
+	satang, _ = decimal{fraction: d.fraction}.minorUnits(c.exponent)
+	return d.negative, d.integer, satang, nil
+}
+
+// WordsFromString is like the package-level WordsFromString but uses c's
+// options. With a rounding mode set, plain decimal input is rounded exactly
+// as written, as described at WordsFromStringRounded.
+func (c *Converter) WordsFromString(money string) (string, error) {
+	c = c.ready()
+	if c.rounding == roundFloat {
+		amount, err := parseLenient(money)
+		if err != nil {
+			return "", err
+		}
+		return c.WordsE(amount)
+	}
+
+	cleanMoney := strings.ReplaceAll(strings.TrimSpace(money), ",", "")
+	d, err := scanDecimal(cleanMoney, -1)
+	if err != nil {
+		amount, err := parseLenient(money)
+		if err != nil {
+			return "", err
+		}
+		d = floatDecimal(amount)
+	}
+
+	rounded, err := d.round(c.exponent, c.rounding)
+	if err != nil {
+		return "", &ParseError{Input: money, Offset: fractionDigitOffset(money, c.exponent), Err: err}
+	}
+	return c.decimalWords(rounded)
+}
+
+// WordsFromStringStrict is like the package-level WordsFromStringStrict but
+// uses c's options.
+func (c *Converter) WordsFromStringStrict(money string) (string, error) {
+	c = c.ready()
+	d, err := scanDecimal(money, c.exponent)
+	if err != nil {
+		return "", err
+	}
+	rounded, err := d.round(c.exponent, c.exactRounding())
+	if err != nil {
+		return "", err
+	}
+	return c.decimalWords(rounded)
+}
+
+// WordsSatang is like the package-level WordsSatang but uses c's options.
+// With WithCurrency, satang counts the minor units of that currency.
+func (c *Converter) WordsSatang(satang int64) (string, error) {
+	c = c.ready()
+	abs := absInt64(satang)
+	unit := pow10[c.exponent]
+	return c.bahtWords(satang < 0, strconv.FormatUint(abs/unit, 10), abs%unit)
+}
+
+// WordsScaled is like the package-level WordsScaled but uses c's options, and
+// returns an error instead of panicking when scale is out of range.
+func (c *Converter) WordsScaled(value int64, scale int) (string, error) {
+	c = c.ready()
+	if scale < 0 || scale >= len(pow10) {
+		return "", fmt.Errorf("bahttext: scale %d out of range [0, %d]", scale, len(pow10)-1)
+	}
+
+	digits := strconv.FormatUint(absInt64(value), 10)
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	d := decimal{negative: value < 0, integer: digits[:len(digits)-scale], fraction: digits[len(digits)-scale:]}
+
+	rounded, err := d.round(c.exponent, c.exactRounding())
+	if err != nil {
+		return "", fmt.Errorf("%w: %d / 10^%d", err, value, scale)
+	}
+	return c.decimalWords(rounded)
+}
+
+// WordsBigInt is like the package-level WordsBigInt but uses c's options.
+func (c *Converter) WordsBigInt(baht *big.Int) (string, error) {
+	c = c.ready()
+	return c.bahtWords(baht.Sign() < 0, strings.TrimPrefix(baht.String(), "-"), 0)
+}
+
+// WordsBigRat is like the package-level WordsBigRat but uses c's options.
+func (c *Converter) WordsBigRat(money *big.Rat) (string, error) {
+	c = c.ready()
+	unit := new(big.Int).SetUint64(pow10[c.exponent])
+	num := new(big.Int).Abs(money.Num())
+	num.Mul(num, unit)
+
+	satang, rem := new(big.Int).QuoRem(num, money.Denom(), new(big.Int))
+	if rem.Sign() != 0 {
+		half := rem.Lsh(rem, 1).Cmp(money.Denom())
+		up, err := c.exactRounding().roundUp(money.Sign() < 0, half, satang.Bit(0) == 1)
+		if err != nil {
+			return "", fmt.Errorf("%w: %s", err, money.RatString())
+		}
+		if up {
+			satang.Add(satang, bigOne)
+		}
+	}
+
+	baht, fraction := satang.QuoRem(satang, unit, new(big.Int))
+	return c.bahtWords(money.Sign() < 0, baht.String(), fraction.Uint64())
+}
+
+// exactRounding returns the rounding mode for input that is already exact,
+// where the float64 rounding of Words means rounding half away from zero.
+func (c *Converter) exactRounding() RoundingMode {
+	if c.rounding == roundFloat {
+		return RoundHalfAwayFromZero
+	}
+	return c.rounding
+}
+
+// decimalWords converts d, which has at most c.exponent fraction digits, to
+// Thai words.
+func (c *Converter) decimalWords(d decimal) (string, error) {
+	satang, _ := decimal{fraction: d.fraction}.minorUnits(c.exponent)
+	return c.bahtWords(d.negative, d.integer, satang)
+}
+
+// bahtWords assembles the final text from an amount already split into whole
+// baht, given as decimal digits, and satang. It fails only for negative
+// amounts under NegativeReject.
+func (c *Converter) bahtWords(negative bool, baht string, satang uint64) (string, error) {
+	tokens, err := c.bahtTokens(negative, baht, satang)
+	if err != nil {
+		return "", err
+	}
+	return tokenText(tokens), nil
+}
+
+// bahtTokens is like bahtWords but returns the parts of the text.
+func (c *Converter) bahtTokens(negative bool, baht string, satang uint64) ([]Token, error) {
+	noBaht := strings.Trim(baht, "0") == ""
+	if noBaht && satang == 0 && c.negativeZero == NegativeZeroUnsigned {
+		negative = false
+	}
+
+	var tokens []Token
+	s, custom := c.speller().(amountSpeller)
+	switch {
+	case custom:
+		tokens = s.spellAmount(noBaht, baht, satang)
+	case c.excel && noBaht && satang != 0:
+		tokens = append(c.minorTokens(satang), word(c.satang, TokenUnit))
+	case satang == 0:
+		tokens = append(c.cardinalTokens(baht, 0), word(c.baht, TokenUnit), word(c.exact, TokenSuffix))
+	default:
+		tokens = append(c.cardinalTokens(baht, 0), word(c.baht, TokenUnit))
+		tokens = append(tokens, c.minorTokens(satang)...)
+		tokens = append(tokens, word(c.satang, TokenUnit))
+	}
+	tokens = c.separate(tokens)
+
+	if negative {
+		var err error
+		if tokens, err = c.signed(tokens); err != nil {
+			if baht = strings.TrimLeft(baht, "0"); baht == "" {
+				baht = "0"
+			}
+			if c.exponent > 0 {
+				baht += fmt.Sprintf(".%0*d", c.exponent, satang)
+			}
+			return nil, fmt.Errorf("%w: -%s", err, baht)
+		}
+	}
+	c.capitalizeTokens(tokens)
+	return tokens, nil
+}
+
+// minorTokens reads satang, whose digits stand below the decimal point.
+func (c *Converter) minorTokens(satang uint64) []Token {
+	return c.cardinalTokens(strconv.FormatUint(satang, 10), -c.exponent)
+}
@@ -0,0 +1,147 @@
+package bahttext
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+)
+
+func TestNewDefaultsMatchPackageFunctions(t *testing.T) {
+	c, err := New()
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	for _, money := range []float64{0, 1.005, -51.995, 1234.56, 10_000_001} {
+		if got, want := c.Words(money), Words(money); got != want {
+			t.Errorf("Converter.Words(%v) = %s, want %s", money, got, want)
+		}
+	}
+
+	for _, money := range []string{"1,234.56", "1.234", "-100", "1e3"} {
+		got, _ := c.WordsFromString(money)
+		want, _ := WordsFromString(money)
+		if got != want {
+			t.Errorf("Converter.WordsFromString(%q) = %s, want %s", money, got, want)
+		}
+	}
+
+	if got, _ := c.WordsSatang(-10050); got != WordsSatang(-10050) {
+		t.Errorf("Converter.WordsSatang(-10050) = %s, want %s", got, WordsSatang(-10050))
+	}
+	if got, _ := c.WordsScaled(12345650, 4); got != WordsScaled(12345650, 4) {
+		t.Errorf("Converter.WordsScaled(12345650, 4) = %s, want %s", got, WordsScaled(12345650, 4))
+	}
+	n := big.NewInt(1_000_001)
+	if got, _ := c.WordsBigInt(n); got != WordsBigInt(n) {
+		t.Errorf("Converter.WordsBigInt(%s) = %s, want %s", n, got, WordsBigInt(n))
+	}
+	r := big.NewRat(1, 3)
+	if got, _ := c.WordsBigRat(r); got != WordsBigRat(r) {
+		t.Errorf("Converter.WordsBigRat(%s) = %s, want %s", r, got, WordsBigRat(r))
+	}
+}
+
+func TestZeroConverter(t *testing.T) {
+	var c Converter
+
+	if got, want := c.Words(-1.25), "ลบหนึ่งบาทยี่สิบห้าสตางค์"; got != want {
+		t.Errorf("zero Converter Words(-1.25) = %s, want %s", got, want)
+	}
+	if got, want := c.Words(1.5), Words(1.5); got != want {
+		t.Errorf("zero Converter Words(1.5) = %s, want %s", got, want)
+	}
+	if got, err := c.WordsSatang(101); err != nil || got != WordsSatang(101) {
+		t.Errorf("zero Converter WordsSatang(101) = %s, %v, want %s", got, err, WordsSatang(101))
+	}
+	if got, want := c.Number(-21), Number(-21); got != want {
+		t.Errorf("zero Converter Number(-21) = %s, want %s", got, want)
+	}
+	if got, want := tokenText(c.Tokens(21.5)), Words(21.5); got != want {
+		t.Errorf("zero Converter Tokens(21.5) read %s, want %s", got, want)
+	}
+
+	// Options apply on top of the defaults
+	english, err := c.With(WithLanguage(English))
+	if err != nil {
+		t.Fatalf("zero Converter With() unexpected error: %v", err)
+	}
+	if got, want := english.Words(21), "Twenty-one baht"; got != want {
+		t.Errorf("Words(21) = %s, want %s", got, want)
+	}
+}
+
+func TestWithRounding(t *testing.T) {
+	c, err := New(WithRounding(RoundHalfEven))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	const oneBaht = "หนึ่งบาทถ้วน"
+	if got := c.Words(1.005); got != oneBaht {
+		t.Errorf("Words(1.005) = %s, want %s", got, oneBaht)
+	}
+	if got, _ := c.WordsFromString("1.005"); got != oneBaht {
+		t.Errorf("WordsFromString(%q) = %s, want %s", "1.005", got, oneBaht)
+	}
+	if got, _ := c.WordsScaled(10050, 4); got != oneBaht {
+		t.Errorf("WordsScaled(10050, 4) = %s, want %s", got, oneBaht)
+	}
+	if got, _ := c.WordsBigRat(big.NewRat(201, 200)); got != oneBaht {
+		t.Errorf("WordsBigRat(201/200) = %s, want %s", got, oneBaht)
+	}
+}
+
+func TestWithRoundingExact(t *testing.T) {
+	c, err := New(WithRounding(RoundExact))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	if _, err := c.WordsScaled(10050, 4); !errors.Is(err, ErrTooManyDecimals) {
+		t.Errorf("WordsScaled(10050, 4) error = %v, want ErrTooManyDecimals", err)
+	}
+	if _, err := c.WordsBigRat(big.NewRat(1, 3)); !errors.Is(err, ErrTooManyDecimals) {
+		t.Errorf("WordsBigRat(1/3) error = %v, want ErrTooManyDecimals", err)
+	}
+	if got, err := c.WordsScaled(10100, 4); err != nil || got != "หนึ่งบาทหนึ่งสตางค์" {
+		t.Errorf("WordsScaled(10100, 4) = %s, %v", got, err)
+	}
+}
+
+func TestNewInvalidOptions(t *testing.T) {
+	for _, mode := range []RoundingMode{roundFloat, RoundExact + 1} {
+		if _, err := New(WithRounding(mode)); err == nil {
+			t.Errorf("New(WithRounding(%v)) expected error, got nil", mode)
+		}
+	}
+
+	c, _ := New()
+	if _, err := c.WordsScaled(1, 20); err == nil {
+		t.Errorf("WordsScaled(1, 20) expected error, got nil")
+	}
+}
+
+func TestConverterConcurrentUse(t *testing.T) {
+	c, err := New(WithRounding(RoundHalfEven))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	want := c.Words(1234.565)
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				if got := c.Words(1234.565); got != want {
+					t.Errorf("Words(1234.565) = %s, want %s", got, want)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,134 @@
+package bahttext
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Currency describes how amounts in a currency are read in Thai.
+type Currency struct {
+	Code     string // ISO 4217 code, such as "USD"
+	Major    string // Thai name of the major unit, such as "ดอลลาร์สหรัฐ"
+	Minor    string // Thai name of the minor unit, such as "เซนต์"; unused when Exponent is 0
+	Exponent int    // number of minor unit digits: 2 for most, 0 for JPY, 3 for KWD
+}
+
+// maxExponent is the largest minor unit exponent in ISO 4217.
+const maxExponent = 4
+
+var (
+	currenciesMu sync.RWMutex
+	currencies   = map[string]Currency{
+		"THB": {Code: "THB", Major: "บาท", Minor: "สตางค์", Exponent: 2},
+		"USD": {Code: "USD", Major: "ดอลลาร์สหรัฐ", Minor: "เซนต์", Exponent: 2},
+		"EUR": {Code: "EUR", Major: "ยูโร", Minor: "เซนต์", Exponent: 2},
+		"JPY": {Code: "JPY", Major: "เยน", Exponent: 0},
+		"CNY": {Code: "CNY", Major: "หยวน", Minor: "เฟิน", Exponent: 2},
+		"SGD": {Code: "SGD", Major: "ดอลลาร์สิงคโปร์", Minor: "เซนต์", Exponent: 2},
+		"KWD": {Code: "KWD", Major: "ดีนาร์คูเวต", Minor: "ฟิลส์", Exponent: 3},
+	}
+)
+
+// RegisterCurrency adds cur to the currencies known to WordsCurrency and
+// WithCurrency. It is meant to be called from an init function. It returns an
+// error if the code is not three upper case letters, the code is already
+// registered, the exponent is outside 0 to 4, or a unit name is rejected the
+// way WithMajorUnit and WithMinorUnit reject words.
+//
+// Example usage:
+//
+//	func init() {
+//		err := baht.RegisterCurrency(baht.Currency{Code: "GBP", Major: "ปอนด์", Minor: "เพนนี", Exponent: 2})
+//		if err != nil {
+//			panic(err)
+//		}
+//	}
+func RegisterCurrency(cur Currency) error {
+	if len(cur.Code) != 3 || !isUpperASCII(cur.Code) {
+		return fmt.Errorf("invalid currency code %q", cur.Code)
+	}
+	if cur.Exponent < 0 || cur.Exponent > maxExponent {
+		return fmt.Errorf("currency %s: exponent %d out of range [0, %d]", cur.Code, cur.Exponent, maxExponent)
+	}
+	if err := checkWord("major unit", cur.Major); err != nil {
+		return fmt.Errorf("currency %s: %w", cur.Code, err)
+	}
+	if cur.Exponent > 0 {
+		if err := checkWord("minor unit", cur.Minor); err != nil {
+			return fmt.Errorf("currency %s: %w", cur.Code, err)
+		}
+	}
+
+	currenciesMu.Lock()
+	defer currenciesMu.Unlock()
+	if _, ok := currencies[cur.Code]; ok {
+		return fmt.Errorf("currency %s already registered", cur.Code)
+	}
+	currencies[cur.Code] = cur
+	return nil
+}
+
+// LookupCurrency returns the registered currency with the given ISO 4217 code.
+func LookupCurrency(code string) (Currency, bool) {
+	currenciesMu.RLock()
+	defer currenciesMu.RUnlock()
+	cur, ok := currencies[code]
+	return cur, ok
+}
+
+// WithCurrency makes the Converter read amounts in the registered currency
+// with the given ISO 4217 code: its unit names replace "บาท" and "สตางค์",
+// and its exponent replaces the two satang digits everywhere, including
+// rounding, WordsSatang and WordsFromStringStrict. It returns an error
+// wrapping ErrUnknownCurrency for codes that are not registered. The unit
+// names are Thai, so it also returns an error for a Converter set to another
+// language or to a custom Speller.
+func WithCurrency(code string) Option {
+	return func(c *Converter) error {
+		cur, ok := LookupCurrency(code)
+		if !ok {
+			return fmt.Errorf("%w: %q", ErrUnknownCurrency, code)
+		}
+		if c.language != Thai || c.custom != nil {
+			return fmt.Errorf("currency %s is read in Thai only", code)
+		}
+		c.baht, c.satang, c.exponent = cur.Major, cur.Minor, cur.Exponent
+		c.currency = cur.Code
+		return nil
+	}
+}
+
+// WordsCurrency converts amount in the currency with the given ISO 4217 code
+// into its Thai word representation. It rounds and rejects amounts the way
+// WordsE does, to the minor unit of the currency.
+//
+// Example usage:
+//
+//	text, err := baht.WordsCurrency("USD", 100.5)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(text) // Output: หนึ่งร้อยดอลลาร์สหรัฐห้าสิบเซนต์
+func WordsCurrency(code string, amount float64) (string, error) {
+	return std.WordsCurrency(code, amount)
+}
+
+// WordsCurrency is like the package-level WordsCurrency but uses c's options.
+// Like WithCurrency, it returns an error unless c reads Thai.
+func (c *Converter) WordsCurrency(code string, amount float64) (string, error) {
+	cc, err := c.With(WithCurrency(code))
+	if err != nil {
+		return "", err
+	}
+	return cc.WordsE(amount)
+}
+
+// isUpperASCII reports whether s holds only the letters A to Z.
+func isUpperASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'A' || s[i] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,198 @@
+package bahttext
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWordsCurrency(t *testing.T) {
+	tests := []struct {
+		name   string
+		code   string
+		amount float64
+		want   string
+	}{
+		{"thb-matches-words", "THB", 1234.56, "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์"},
+		{"usd", "USD", 100.5, "หนึ่งร้อยดอลลาร์สหรัฐห้าสิบเซนต์"},
+		{"usd-whole", "USD", 20, "ยี่สิบดอลลาร์สหรัฐถ้วน"},
+		{"eur-negative", "EUR", -2.25, "ลบสองยูโรยี่สิบห้าเซนต์"},
+		{"jpy", "JPY", 1000, "หนึ่งพันเยนถ้วน"},
+		{"jpy-rounds-to-yen", "JPY", 1000.5, "หนึ่งพันเอ็ดเยนถ้วน"},
+		{"jpy-rounds-down", "JPY", 1000.4, "หนึ่งพันเยนถ้วน"},
+		{"cny", "CNY", 0.01, "ศูนย์หยวนหนึ่งเฟิน"},
+		{"sgd", "SGD", 1.1, "หนึ่งดอลลาร์สิงคโปร์สิบเซนต์"},
+		{"kwd", "KWD", 12.345, "สิบสองดีนาร์คูเวตสามร้อยสี่สิบห้าฟิลส์"},
+		{"kwd-small", "KWD", 1.005, "หนึ่งดีนาร์คูเวตห้าฟิลส์"},
+		{"kwd-rounds", "KWD", 0.0005, "ศูนย์ดีนาร์คูเวตหนึ่งฟิลส์"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := WordsCurrency(tt.code, tt.amount)
+			if err != nil {
+				t.Fatalf("WordsCurrency(%s, %v) unexpected error: %v", tt.code, tt.amount, err)
+			}
+			if got != tt.want {
+				t.Errorf("WordsCurrency(%s, %v) = %s, want %s", tt.code, tt.amount, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithCurrencyExponent(t *testing.T) {
+	kwd, err := New(WithCurrency("KWD"))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	jpy, err := New(WithCurrency("JPY"))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		convert func() (string, error)
+		want    string
+	}{
+		{"kwd-minor-units", func() (string, error) { return kwd.WordsSatang(1500) }, "หนึ่งดีนาร์คูเวตห้าร้อยฟิลส์"},
+		{"kwd-strict", func() (string, error) { return kwd.WordsFromStringStrict("1.234") }, "หนึ่งดีนาร์คูเวตสองร้อยสามสิบสี่ฟิลส์"},
+		{"kwd-string", func() (string, error) { return kwd.WordsFromString("2.5") }, "สองดีนาร์คูเวตห้าร้อยฟิลส์"},
+		{"kwd-scaled", func() (string, error) { return kwd.WordsScaled(12345, 4) }, "หนึ่งดีนาร์คูเวตสองร้อยสามสิบห้าฟิลส์"},
+		{"jpy-minor-units", func() (string, error) { return jpy.WordsSatang(1500) }, "หนึ่งพันห้าร้อยเยนถ้วน"},
+		{"jpy-scaled", func() (string, error) { return jpy.WordsScaled(12345, 2) }, "หนึ่งร้อยยี่สิบสามเยนถ้วน"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.convert()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := jpy.WordsFromStringStrict("1.2"); !errors.Is(err, ErrTooManyDecimals) {
+		t.Errorf("JPY WordsFromStringStrict(1.2) error = %v, want ErrTooManyDecimals", err)
+	}
+
+	exact, err := New(WithCurrency("KWD"), WithRounding(RoundExact))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	if _, err := exact.WordsFromString("1.2345"); !errors.Is(err, ErrTooManyDecimals) {
+		t.Errorf("KWD RoundExact WordsFromString(1.2345) error = %v, want ErrTooManyDecimals", err)
+	}
+}
+
+func TestWordsCurrencyUnknown(t *testing.T) {
+	if _, err := WordsCurrency("XYZ", 1); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("WordsCurrency(XYZ) error = %v, want ErrUnknownCurrency", err)
+	}
+	if _, err := WordsCurrency("usd", 1); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("WordsCurrency(usd) error = %v, want ErrUnknownCurrency", err)
+	}
+	if _, err := New(WithCurrency("")); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("New(WithCurrency(\"\")) error = %v, want ErrUnknownCurrency", err)
+	}
+}
+
+func TestRegisterCurrency(t *testing.T) {
+	t.Cleanup(func() {
+		currenciesMu.Lock()
+		delete(currencies, "XTS")
+		currenciesMu.Unlock()
+	})
+
+	err := RegisterCurrency(Currency{Code: "XTS", Major: "หน่วยทดสอบ", Minor: "ย่อย", Exponent: 1})
+	if err != nil {
+		t.Fatalf("RegisterCurrency() unexpected error: %v", err)
+	}
+
+	got, err := WordsCurrency("XTS", 2.5)
+	if err != nil {
+		t.Fatalf("WordsCurrency(XTS) unexpected error: %v", err)
+	}
+	if want := "สองหน่วยทดสอบห้าย่อย"; got != want {
+		t.Errorf("WordsCurrency(XTS, 2.5) = %s, want %s", got, want)
+	}
+
+	if cur, ok := LookupCurrency("XTS"); !ok || cur.Exponent != 1 {
+		t.Errorf("LookupCurrency(XTS) = %+v, %v", cur, ok)
+	}
+	if err := RegisterCurrency(Currency{Code: "XTS", Major: "อื่น", Minor: "ย่อย", Exponent: 2}); err == nil {
+		t.Errorf("RegisterCurrency(XTS) twice expected error, got nil")
+	}
+}
+
+func TestRegisterCurrencyInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		cur  Currency
+	}{
+		{"lower-case-code", Currency{Code: "xts", Major: "หน่วย", Minor: "ย่อย", Exponent: 2}},
+		{"short-code", Currency{Code: "XT", Major: "หน่วย", Minor: "ย่อย", Exponent: 2}},
+		{"builtin", Currency{Code: "USD", Major: "ดอลลาร์", Minor: "เซนต์", Exponent: 2}},
+		{"negative-exponent", Currency{Code: "XTS", Major: "หน่วย", Minor: "ย่อย", Exponent: -1}},
+		{"large-exponent", Currency{Code: "XTS", Major: "หน่วย", Minor: "ย่อย", Exponent: 5}},
+		{"empty-major", Currency{Code: "XTS", Minor: "ย่อย", Exponent: 2}},
+		{"empty-minor", Currency{Code: "XTS", Major: "หน่วย", Exponent: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := RegisterCurrency(tt.cur); err == nil {
+				t.Errorf("RegisterCurrency(%+v) expected error, got nil", tt.cur)
+			}
+		})
+	}
+
+	if _, ok := LookupCurrency("XTS"); ok {
+		t.Errorf("LookupCurrency(XTS) found a rejected currency")
+	}
+}
+
+func TestCurrencyThaiOnly(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+	}{
+		{"english-after", []Option{WithLanguage(English), WithCurrency("JPY")}},
+		{"english-before", []Option{WithCurrency("JPY"), WithLanguage(English)}},
+		{"rtgs-after", []Option{WithLanguage(RTGS), WithCurrency("USD")}},
+		{"rtgs-before", []Option{WithCurrency("USD"), WithLanguage(RTGS)}},
+		{"chinese-after", []Option{WithLanguage(Chinese), WithCurrency("USD")}},
+		{"lao-before", []Option{WithCurrency("USD"), WithLocale("lo")}},
+		{"thai-before", []Option{WithCurrency("JPY"), WithLanguage(Thai)}},
+		{"speller-after", []Option{WithSpeller(indonesianSpeller{}), WithCurrency("USD")}},
+		{"speller-before", []Option{WithCurrency("USD"), WithSpeller(indonesianSpeller{})}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if c, err := New(tt.opts...); err == nil {
+				t.Errorf("New() = %v, want an error", c)
+			}
+		})
+	}
+
+	for _, lang := range []Language{English, RTGS, Chinese, Lao} {
+		c, err := New(WithLanguage(lang))
+		if err != nil {
+			t.Fatalf("New(WithLanguage(%v)) unexpected error: %v", lang, err)
+		}
+		if got, err := c.WordsCurrency("USD", 1.5); err == nil {
+			t.Errorf("%v WordsCurrency(USD, 1.5) = %s, want an error", lang, got)
+		}
+	}
+
+	c, err := New(WithDialect(Northern), WithCurrency("USD"))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	if got, want := c.Words(21.25), "ซาวเอ็ดดอลลาร์สหรัฐซาวห้าเซนต์"; got != want {
+		t.Errorf("Words(21.25) = %s, want %s", got, want)
+	}
+}
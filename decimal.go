@@ -0,0 +1,78 @@
+package bahttext
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Decimal reads a decimal number in Thai for values that are not money, such
+// as measurements and rates. The integer part is read as a cardinal, like
+// Number, and the fraction digit by digit after "จุด", keeping every digit
+// written, including trailing zeros:
+//
+//	Decimal("3.1415") -> "สามจุดหนึ่งสี่หนึ่งห้า"
+//	Decimal("0.05")   -> "ศูนย์จุดศูนย์ห้า"
+//	Decimal("1.50")   -> "หนึ่งจุดห้าศูนย์"
+//
+// The input follows the grammar of ParseStrict except that the fraction may
+// have any number of digits. Errors are reported as a *ParseError.
+func Decimal(number string) (string, error) {
+	return std.Decimal(number)
+}
+
+// DecimalFloat is like Decimal for a float64, formatted with the given number
+// of fraction digits, or with the fewest digits that represent it exactly if
+// precision is -1. It returns an error wrapping ErrNotFinite for NaN and Inf.
+//
+// Example usage:
+//
+//	text, err := baht.DecimalFloat(2.5, 2)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(text) // Output: สองจุดห้าศูนย์
+func DecimalFloat(number float64, precision int) (string, error) {
+	return std.DecimalFloat(number, precision)
+}
+
+// Decimal is like the package-level Decimal but uses c's options.
+func (c *Converter) Decimal(number string) (string, error) {
+	c = c.ready()
+	d, err := scanDecimal(number, -1)
+	if err != nil {
+		return "", err
+	}
+	return c.decimalNumber(d), nil
+}
+
+// DecimalFloat is like the package-level DecimalFloat but uses c's options.
+func (c *Converter) DecimalFloat(number float64, precision int) (string, error) {
+	c = c.ready()
+	if math.IsNaN(number) || math.IsInf(number, 0) {
+		return "", fmt.Errorf("%w: %v", ErrNotFinite, number)
+	}
+	if precision < -1 {
+		return "", fmt.Errorf("invalid precision %d", precision)
+	}
+
+	s := strconv.FormatFloat(number, 'f', precision, 64)
+	d := decimal{negative: strings.HasPrefix(s, "-")}
+	d.integer, d.fraction, _ = strings.Cut(strings.TrimPrefix(s, "-"), ".")
+	return c.decimalNumber(d), nil
+}
+
+// decimalNumber reads d as a cardinal followed by its fraction digits.
+func (c *Converter) decimalNumber(d decimal) string {
+	text := c.number(d.negative && !d.isZero(), d.integer)
+	if d.fraction == "" {
+		return text
+	}
+
+	words := []string{text, c.point}
+	for _, char := range d.fraction {
+		words = append(words, c.digitWord(int(char-'0')))
+	}
+	return c.join(words...)
+}
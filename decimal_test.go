@@ -0,0 +1,86 @@
+package bahttext
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr error
+	}{
+		{"integer", "12", "สิบสอง", nil},
+		{"pi", "3.1415", "สามจุดหนึ่งสี่หนึ่งห้า", nil},
+		{"leading-zero-fraction", "0.05", "ศูนย์จุดศูนย์ห้า", nil},
+		{"trailing-zero", "1.50", "หนึ่งจุดห้าศูนย์", nil},
+		{"only-zeros", "0.000", "ศูนย์จุดศูนย์ศูนย์ศูนย์", nil},
+		{"fraction-one", "21.1", "ยี่สิบเอ็ดจุดหนึ่ง", nil},
+		{"grouped", "1,000.25", "หนึ่งพันจุดสองห้า", nil},
+		{"negative", "-2.75", "ลบสองจุดเจ็ดห้า", nil},
+		{"negative-zero", "-0.0", "ศูนย์จุดศูนย์", nil},
+		{"surrounding-spaces", " 7.5 ", "เจ็ดจุดห้า", nil},
+
+		{"empty", "", "", ErrEmpty},
+		{"exponent", "1e3", "", ErrSyntax},
+		{"trailing-dot", "1.", "", ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Decimal(tt.input)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Decimal(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Decimal(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.want {
+				t.Errorf("Decimal(%q) = %s, want %s", tt.input, result, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimalFloat(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     float64
+		precision int
+		want      string
+	}{
+		{"shortest", 3.1415, -1, "สามจุดหนึ่งสี่หนึ่งห้า"},
+		{"shortest-integer", 42, -1, "สี่สิบสอง"},
+		{"padded", 2.5, 2, "สองจุดห้าศูนย์"},
+		{"rounded", 0.056, 2, "ศูนย์จุดศูนย์หก"},
+		{"no-fraction", 7.4, 0, "เจ็ด"},
+		{"negative", -0.05, -1, "ลบศูนย์จุดศูนย์ห้า"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := DecimalFloat(tt.input, tt.precision)
+			if err != nil {
+				t.Fatalf("DecimalFloat(%v, %d) unexpected error: %v", tt.input, tt.precision, err)
+			}
+			if result != tt.want {
+				t.Errorf("DecimalFloat(%v, %d) = %s, want %s", tt.input, tt.precision, result, tt.want)
+			}
+		})
+	}
+
+	if _, err := DecimalFloat(math.NaN(), 2); !errors.Is(err, ErrNotFinite) {
+		t.Errorf("DecimalFloat(NaN) error = %v, want ErrNotFinite", err)
+	}
+	if _, err := DecimalFloat(1, -2); err == nil {
+		t.Errorf("DecimalFloat(1, -2) expected error, got nil")
+	}
+}
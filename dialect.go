@@ -0,0 +1,156 @@
+package bahttext
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Dialect selects a built-in vocabulary pack for Thai readings.
+type Dialect int
+
+const (
+	// Central is the standard Thai vocabulary, the default.
+	Central Dialect = iota
+	// Northern is the Northern Thai (คำเมือง) vocabulary, which reads two in
+	// the tens place as "ซาว": 21 reads "ซาวเอ็ด".
+	Northern
+	// Isan is the Northeastern Thai vocabulary, which reads two in the tens
+	// place as "ซาว" and hundred as "ฮ้อย": 121 reads "หนึ่งฮ้อยซาวเอ็ด".
+	Isan
+)
+
+func (d Dialect) String() string {
+	switch d {
+	case Central:
+		return "Central"
+	case Northern:
+		return "Northern"
+	case Isan:
+		return "Isan"
+	}
+	return "Dialect(" + strconv.Itoa(int(d)) + ")"
+}
+
+// Vocabulary is a pack of words that override the standard Thai ones. Empty
+// fields, and empty entries in Digits and Places, keep the standard word. It
+// can be loaded from JSON with LoadVocabulary:
+//
+//	{
+//		"digits": ["", "", "", "", "", "", "", "", ""],
+//		"places": ["", "ฮ้อย", "", "", "", ""],
+//		"twenty": ["ซาว"],
+//		"trailing_one": "เอ็ด"
+//	}
+type Vocabulary struct {
+	Digits      []string `json:"digits,omitempty"`       // words for one to nine; 9 entries when set
+	Places      []string `json:"places,omitempty"`       // words for สิบ, ร้อย, พัน, หมื่น, แสน and ล้าน; 6 entries when set
+	Twenty      []string `json:"twenty,omitempty"`       // words for two in the tens place, as in ยี่สิบ
+	TrailingOne string   `json:"trailing_one,omitempty"` // a trailing one after other words, as in สิบเอ็ด
+}
+
+// dialects holds the built-in vocabulary packs.
+var dialects = map[Dialect]Vocabulary{
+	Northern: {Twenty: []string{"ซาว"}},
+	Isan:     {Places: []string{"", "ฮ้อย", "", "", "", ""}, Twenty: []string{"ซาว"}},
+}
+
+// WithDialect makes the Converter read Thai with the built-in vocabulary pack
+// of d. It replaces any vocabulary set before, and only applies to Thai
+// readings.
+func WithDialect(d Dialect) Option {
+	return func(c *Converter) error {
+		if d == Central {
+			c.vocabulary = nil
+			return nil
+		}
+		v, ok := dialects[d]
+		if !ok {
+			return fmt.Errorf("invalid dialect %d", int(d))
+		}
+		return WithVocabulary(v)(c)
+	}
+}
+
+// WithVocabulary makes the Converter read Thai with the words of v over the
+// standard ones. It replaces any vocabulary set before, and only applies to
+// Thai readings. It returns an error if Digits or Places have the wrong
+// number of entries, or a word is rejected the way WithSign rejects words.
+func WithVocabulary(v Vocabulary) Option {
+	return func(c *Converter) error {
+		script, err := v.script()
+		if err != nil {
+			return err
+		}
+		c.vocabulary = script
+		return nil
+	}
+}
+
+// LoadVocabulary reads a Vocabulary in JSON from r, as described at
+// Vocabulary, and checks it the way WithVocabulary does. Unknown fields are
+// an error.
+func LoadVocabulary(r io.Reader) (Vocabulary, error) {
+	var v Vocabulary
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&v); err != nil {
+		return Vocabulary{}, fmt.Errorf("vocabulary: %w", err)
+	}
+	if _, err := v.script(); err != nil {
+		return Vocabulary{}, err
+	}
+	return v, nil
+}
+
+// script returns the standard Thai script with the words of v over it.
+func (v Vocabulary) script() (*thaiScript, error) {
+	script := thai
+	var err error
+	if script.digits, err = overrideWords("digit", thai.digits, v.Digits); err != nil {
+		return nil, err
+	}
+	if script.places, err = overrideWords("place", thai.places, v.Places); err != nil {
+		return nil, err
+	}
+
+	if len(v.Twenty) > 0 {
+		for _, word := range v.Twenty {
+			if err := checkWord("twenty", word); err != nil {
+				return nil, err
+			}
+		}
+		script.twenty = append([]string(nil), v.Twenty...)
+	}
+	if v.TrailingOne != "" {
+		if err := checkWord("trailing one", v.TrailingOne); err != nil {
+			return nil, err
+		}
+		script.et = v.TrailingOne
+	}
+	return &script, nil
+}
+
+// overrideWords returns base, whose first entry is the empty word for zero,
+// with the non-empty entries of words over the rest.
+func overrideWords(kind string, base, words []string) ([]string, error) {
+	if len(words) == 0 {
+		return base, nil
+	}
+	if len(words) != len(base)-1 {
+		return nil, fmt.Errorf("vocabulary needs %d %s words, got %d", len(base)-1, kind, len(words))
+	}
+
+	merged := append([]string(nil), base...)
+	for i, word := range words {
+		if word == "" {
+			continue
+		}
+		if err := checkWord(kind, word); err != nil {
+			return nil, err
+		}
+		merged[i+1] = word
+	}
+	return merged, nil
+}
@@ -0,0 +1,137 @@
+package bahttext
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestDialectWords(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect Dialect
+		input   float64
+		want    string
+	}{
+		{"central twenty-one", Central, 21, "ยี่สิบเอ็ดบาทถ้วน"},
+		{"central hundred", Central, 121, "หนึ่งร้อยยี่สิบเอ็ดบาทถ้วน"},
+
+		{"northern twenty", Northern, 20, "ซาวบาทถ้วน"},
+		{"northern twenty-one", Northern, 21, "ซาวเอ็ดบาทถ้วน"},
+		{"northern hundred", Northern, 121, "หนึ่งร้อยซาวเอ็ดบาทถ้วน"},
+		{"northern satang", Northern, 1.25, "หนึ่งบาทซาวห้าสตางค์"},
+		{"northern million", Northern, 20_000_020, "ซาวล้านซาวบาทถ้วน"},
+		{"northern negative", Northern, -22, "ลบซาวสองบาทถ้วน"},
+
+		{"isan twenty-one", Isan, 21, "ซาวเอ็ดบาทถ้วน"},
+		{"isan hundred", Isan, 121, "หนึ่งฮ้อยซาวเอ็ดบาทถ้วน"},
+		{"isan hundred-one", Isan, 101, "หนึ่งฮ้อยเอ็ดบาทถ้วน"},
+		{"isan thousands", Isan, 1_200_300, "หนึ่งล้านสองแสนสามฮ้อยบาทถ้วน"},
+		{"isan thirty", Isan, 30.5, "สามสิบบาทห้าสิบสตางค์"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(WithDialect(tt.dialect))
+			if err != nil {
+				t.Fatalf("New(WithDialect(%v)) unexpected error: %v", tt.dialect, err)
+			}
+			if got := c.Words(tt.input); got != tt.want {
+				t.Errorf("Words(%v) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDialectOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  []Option
+		input float64
+		want  string
+	}{
+		{"royal institute", []Option{WithDialect(Isan), WithReadingStyle(RoyalInstitute)}, 101, "หนึ่งฮ้อยหนึ่งบาทถ้วน"},
+		{"omit leading one", []Option{WithDialect(Isan), OmitLeadingOne()}, 121, "ฮ้อยซาวเอ็ดบาทถ้วน"},
+		{"units", []Option{WithDialect(Northern), WithMajorUnit("ดอลลาร์")}, 21, "ซาวเอ็ดดอลลาร์ถ้วน"},
+		{"back to central", []Option{WithDialect(Isan), WithDialect(Central)}, 121, "หนึ่งร้อยยี่สิบเอ็ดบาทถ้วน"},
+		{"other language", []Option{WithDialect(Northern), WithLanguage(English)}, 21, "Twenty-one baht"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(tt.opts...)
+			if err != nil {
+				t.Fatalf("New() unexpected error: %v", err)
+			}
+			if got := c.Words(tt.input); got != tt.want {
+				t.Errorf("Words(%v) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := New(WithDialect(Dialect(42))); err == nil {
+		t.Error("New(WithDialect(42)) expected an error")
+	}
+	if got := Words(21); got != "ยี่สิบเอ็ดบาทถ้วน" {
+		t.Errorf("Words(21) = %q after WithDialect, want the default reading", got)
+	}
+}
+
+func TestLoadVocabulary(t *testing.T) {
+	file, err := os.Open("testdata/vocabulary.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	v, err := LoadVocabulary(file)
+	if err != nil {
+		t.Fatalf("LoadVocabulary() unexpected error: %v", err)
+	}
+	c, err := New(WithVocabulary(v))
+	if err != nil {
+		t.Fatalf("New(WithVocabulary()) unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		input float64
+		want  string
+	}{
+		{1, "นึ่งบาทถ้วน"},
+		{21, "ซาวเอ็ดบาทถ้วน"},
+		{121, "นึ่งฮ้อยซาวเอ็ดบาทถ้วน"},
+		{1_000_001, "นึ่งล้านเอ็ดบาทถ้วน"},
+	}
+	for _, tt := range tests {
+		if got := c.Words(tt.input); got != tt.want {
+			t.Errorf("Words(%v) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestLoadVocabularyInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"not json", `digits`},
+		{"unknown field", `{"hundred": "ฮ้อย"}`},
+		{"short digits", `{"digits": ["นึ่ง"]}`},
+		{"long places", `{"places": ["", "", "", "", "", "", ""]}`},
+		{"digit word with digits", `{"digits": ["1", "", "", "", "", "", "", "", ""]}`},
+		{"empty twenty", `{"twenty": [""]}`},
+		{"spaced trailing one", `{"trailing_one": " เอ็ด"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := LoadVocabulary(strings.NewReader(tt.input)); err == nil {
+				t.Errorf("LoadVocabulary(%q) expected an error", tt.input)
+			}
+		})
+	}
+
+	if _, err := New(WithVocabulary(Vocabulary{Places: []string{"ร้อย"}})); err == nil {
+		t.Error("New(WithVocabulary()) expected an error for one place word")
+	}
+}
@@ -0,0 +1,193 @@
+package bahttext
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// englishFormat holds the English options of a Converter.
+type englishFormat struct {
+	and            bool // "and" before the satang
+	only           bool // "only" at the end
+	hyphen         bool // "thirty-four" rather than "thirty four"
+	capitalization Capitalization
+	chequeFraction bool // satang as "56/100"
+}
+
+var (
+	englishOnes = []string{"", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+	englishTens   = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	englishScales = []string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion",
+		"sextillion", "septillion", "octillion", "nonillion", "decillion"}
+)
+
+// EnglishAnd sets whether English readings join the satang with "and", as in
+// "one baht and fifty satang". It is on by default.
+func EnglishAnd(on bool) Option {
+	return func(c *Converter) error {
+		c.english.and = on
+		return nil
+	}
+}
+
+// EnglishOnly sets whether English readings end with "only", as in
+// "one baht only". It is off by default. The word can be changed with
+// WithExactSuffix.
+func EnglishOnly(on bool) Option {
+	return func(c *Converter) error {
+		c.english.only = on
+		return nil
+	}
+}
+
+// EnglishHyphen sets whether English readings hyphenate compound numbers from
+// twenty-one to ninety-nine. It is on by default.
+func EnglishHyphen(on bool) Option {
+	return func(c *Converter) error {
+		c.english.hyphen = on
+		return nil
+	}
+}
+
+// EnglishCapitalization sets the letter case of English readings of amounts.
+// It is CapitalizeSentence by default.
+func EnglishCapitalization(capitalization Capitalization) Option {
+	return func(c *Converter) error {
+		if err := capitalization.valid(); err != nil {
+			return err
+		}
+		c.english.capitalization = capitalization
+		return nil
+	}
+}
+
+// EnglishChequeFraction sets whether English readings write the satang as a
+// fraction of a hundred, the convention on cheques: 1234.56 reads
+// "One thousand two hundred thirty-four baht and 56/100", and a whole amount
+// ends with "and 00/100". It is off by default.
+func EnglishChequeFraction(on bool) Option {
+	return func(c *Converter) error {
+		c.english.chequeFraction = on
+		return nil
+	}
+}
+
+// englishUnits are the words of English readings.
+var englishUnits = Units{
+	Sign:       "minus",
+	Major:      "baht",
+	Minor:      "satang",
+	Exact:      "only",
+	Zero:       "zero",
+	Point:      "point",
+	Annotation: "(loss)",
+	Separator:  " ",
+}
+
+// englishSpeller is the Speller of English, with the short scale names.
+type englishSpeller struct {
+	c *Converter
+}
+
+func (s englishSpeller) GroupSize() int { return 3 }
+
+func (s englishSpeller) SpellDigit(digit int) string { return englishOnes[digit] }
+
+func (s englishSpeller) Units() Units { return englishUnits }
+
+// JoinScale names the scale of non-zero groups. Amounts beyond the largest
+// scale chain it, as in "one thousand decillion", so every multiple of it is
+// named even after a group of zeros.
+func (s englishSpeller) JoinScale(words []string, index int, zero bool) []string {
+	for _, t := range s.scaleTokens(index, zero) {
+		words = append(words, t.Text)
+	}
+	return words
+}
+
+func (s englishSpeller) scaleTokens(index int, zero bool) []Token {
+	top := len(englishScales) - 1
+	scale := englishScales[index%top]
+	if index%top == 0 {
+		scale = englishScales[top]
+	} else if zero {
+		return nil
+	}
+	return []Token{{Text: scale, Kind: TokenScale, Digit: -1, Position: 3 * index}}
+}
+
+func (s englishSpeller) SpellGroup(words []string, group string, index int, skipped bool) []string {
+	for _, t := range s.groupTokens(group, index, len(words) > 0, skipped) {
+		words = append(words, t.Text)
+	}
+	return words
+}
+
+// groupTokens spells a group of three digits. A tens word, as in "thirty" or
+// "thirty-four", stands for the tens digit, and a word from "ten" to
+// "nineteen" is the TokenSpecial of the tens digit one.
+func (s englishSpeller) groupTokens(group string, index int, started, skipped bool) []Token {
+	n, _ := strconv.Atoi(group)
+	var tokens []Token
+	add := func(text string, kind TokenKind, digit, place int) {
+		tokens = append(tokens, Token{Text: text, Kind: kind, Digit: digit, Position: 3*index + place})
+	}
+
+	if hundreds := n / 100; hundreds > 0 {
+		add(englishOnes[hundreds], TokenDigit, hundreds, 2)
+		add("hundred", TokenPlace, hundreds, 2)
+	}
+
+	tens, ones := n/10%10, n%10
+	switch {
+	case tens == 0 && ones == 0:
+	case tens == 0:
+		add(englishOnes[ones], TokenDigit, ones, 0)
+	case tens == 1:
+		add(englishOnes[10+ones], TokenSpecial, 1, 1)
+	case ones == 0:
+		add(englishTens[tens], TokenDigit, tens, 1)
+	case s.c.english.hyphen:
+		add(englishTens[tens]+"-"+englishOnes[ones], TokenDigit, tens, 1)
+	default:
+		add(englishTens[tens], TokenDigit, tens, 1)
+		add(englishOnes[ones], TokenDigit, ones, 0)
+	}
+	return tokens
+}
+
+// spellAmount assembles English amounts, with the "and", "only" and cheque
+// fraction options.
+func (s englishSpeller) spellAmount(noBaht bool, baht string, satang uint64) []Token {
+	c := s.c
+
+	// A currency without minor units has no fraction to write
+	fraction := c.english.chequeFraction && c.exponent > 0
+
+	var tokens []Token
+	if !(c.excel && noBaht && satang != 0) || fraction {
+		tokens = append(c.cardinalTokens(baht, 0), word(c.baht, TokenUnit))
+	}
+
+	if fraction || satang != 0 {
+		if c.english.and && len(tokens) > 0 {
+			tokens = append(tokens, word("and", TokenWord))
+		}
+		if fraction {
+			tokens = append(tokens, word(fmt.Sprintf("%0*d/%d", c.exponent, satang, pow10[c.exponent]), TokenWord))
+		} else {
+			tokens = append(tokens, c.minorTokens(satang)...)
+			tokens = append(tokens, word(c.satang, TokenUnit))
+		}
+	}
+
+	if c.english.only {
+		tokens = append(tokens, word(c.exact, TokenSuffix))
+	}
+	return tokens
+}
+
+func (s englishSpeller) capitalization() Capitalization {
+	return s.c.english.capitalization
+}
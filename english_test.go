@@ -0,0 +1,169 @@
+package bahttext
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestEnglishWords(t *testing.T) {
+	c, err := New(WithLanguage(English))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		input float64
+		want  string
+	}{
+		{"zero", 0, "Zero baht"},
+		{"one", 1, "One baht"},
+		{"teen", 15, "Fifteen baht"},
+		{"compound", 21, "Twenty-one baht"},
+		{"round-tens", 90, "Ninety baht"},
+		{"hundred", 100, "One hundred baht"},
+		{"thousands", 1234.56, "One thousand two hundred thirty-four baht and fifty-six satang"},
+		{"million", 1_000_000, "One million baht"},
+		{"gaps", 1_001_001, "One million one thousand one baht"},
+		{"billion", 2_000_000_015, "Two billion fifteen baht"},
+		{"satang-only", 0.5, "Zero baht and fifty satang"},
+		{"negative", -5.5, "Minus five baht and fifty satang"},
+		{"max", 999999999.99, "Nine hundred ninety-nine million nine hundred ninety-nine thousand nine hundred ninety-nine baht and ninety-nine satang"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.Words(tt.input); got != tt.want {
+				t.Errorf("English Words(%v) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnglishOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  []Option
+		input float64
+		want  string
+	}{
+		{"no-and", []Option{EnglishAnd(false)}, 1.5, "One baht fifty satang"},
+		{"only", []Option{EnglishOnly(true)}, 1000, "One thousand baht only"},
+		{"only-satang", []Option{EnglishOnly(true)}, 1.25, "One baht and twenty-five satang only"},
+		{"only-word", []Option{EnglishOnly(true), WithExactSuffix("exactly")}, 1, "One baht exactly"},
+		{"no-hyphen", []Option{EnglishHyphen(false)}, 34, "Thirty four baht"},
+		{"title", []Option{EnglishCapitalization(CapitalizeTitle), EnglishOnly(true)}, 1234.56, "One Thousand Two Hundred Thirty-Four Baht and Fifty-Six Satang Only"},
+		{"upper", []Option{EnglishCapitalization(CapitalizeUpper)}, 21.5, "TWENTY-ONE BAHT AND FIFTY SATANG"},
+		{"lower", []Option{EnglishCapitalization(CapitalizeLower)}, 21.5, "twenty-one baht and fifty satang"},
+		{"cheque", []Option{EnglishChequeFraction(true)}, 1234.56, "One thousand two hundred thirty-four baht and 56/100"},
+		{"cheque-whole", []Option{EnglishChequeFraction(true), EnglishOnly(true)}, 100, "One hundred baht and 00/100 only"},
+		{"cheque-satang", []Option{EnglishChequeFraction(true)}, 0.05, "Zero baht and 05/100"},
+		{"units", []Option{WithMajorUnit("dollars"), WithMinorUnit("cents")}, 2.5, "Two dollars and fifty cents"},
+		{"parentheses", []Option{WithNegativeStyle(NegativeParentheses, NegativeZeroSigned)}, -1000, "(One thousand baht)"},
+		{"annotation", []Option{WithNegativeStyle(NegativeAnnotation, NegativeZeroSigned)}, -1000, "One thousand baht (loss)"},
+		{"excel", []Option{ExcelCompatible()}, 0.5, "Fifty satang"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(append([]Option{WithLanguage(English)}, tt.opts...)...)
+			if err != nil {
+				t.Fatalf("New() unexpected error: %v", err)
+			}
+			if got := c.Words(tt.input); got != tt.want {
+				t.Errorf("English Words(%v) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnglishLargeNumbers(t *testing.T) {
+	c, err := New(WithLanguage(English))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"1000000000000000000", "One quintillion baht"},
+		{"1000000000000000000000000000000000", "One decillion baht"},
+		{"10000000000000000000000000000000000000005", "Ten million decillion five baht"},
+		{"-9223372036854775808", "Minus nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eight baht"},
+	}
+
+	for _, tt := range tests {
+		n, _ := new(big.Int).SetString(tt.input, 10)
+		got, err := c.WordsBigInt(n)
+		if err != nil {
+			t.Fatalf("WordsBigInt(%s) unexpected error: %v", tt.input, err)
+		}
+		if got != tt.want {
+			t.Errorf("English WordsBigInt(%s) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestEnglishMatchesThaiDecomposition(t *testing.T) {
+	c, err := New(WithLanguage(English), EnglishChequeFraction(true))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	// 1.005 is slightly below a half satang as a float64, and Words rounds it
+	// the same way in both languages.
+	if got, want := c.Words(1.005), "One baht and 00/100"; got != want {
+		t.Errorf("English Words(1.005) = %s, want %s", got, want)
+	}
+	if got, want := Words(1.005), "หนึ่งบาทถ้วน"; got != want {
+		t.Errorf("Words(1.005) = %s, want %s", got, want)
+	}
+}
+
+func TestEnglishNumber(t *testing.T) {
+	c, err := New(WithLanguage(English))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	if got, want := c.Number(-5), "minus five"; got != want {
+		t.Errorf("English Number(-5) = %s, want %s", got, want)
+	}
+	if got, want := c.Number(0), "zero"; got != want {
+		t.Errorf("English Number(0) = %s, want %s", got, want)
+	}
+	got, err := c.Decimal("-0.05")
+	if err != nil {
+		t.Fatalf("English Decimal(-0.05) unexpected error: %v", err)
+	}
+	if want := "minus zero point zero five"; got != want {
+		t.Errorf("English Decimal(-0.05) = %s, want %s", got, want)
+	}
+}
+
+func TestLanguageInvalid(t *testing.T) {
+	if _, err := New(WithLanguage(Language(5))); err == nil {
+		t.Errorf("New(WithLanguage(5)) expected error, got nil")
+	}
+	if _, err := New(EnglishCapitalization(Capitalization(9))); err == nil {
+		t.Errorf("New(EnglishCapitalization(9)) expected error, got nil")
+	}
+
+	c, err := New(WithLanguage(English), WithNegativeStyle(NegativeReject, NegativeZeroSigned))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	if _, err := c.WordsE(-1); !errors.Is(err, ErrNegative) {
+		t.Errorf("English WordsE(-1) error = %v, want ErrNegative", err)
+	}
+
+	thai, err := New(WithLanguage(English), WithLanguage(Thai))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	if got, want := thai.Words(-1.5), "ลบหนึ่งบาทห้าสิบสตางค์"; got != want {
+		t.Errorf("Thai Words(-1.5) = %s, want %s", got, want)
+	}
+}
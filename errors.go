@@ -0,0 +1,122 @@
+package bahttext
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Sentinel errors reported by the conversion functions. Use errors.Is to test
+// for them; string parsing wraps them in a *ParseError.
+var (
+	// ErrEmpty is reported when the input holds no amount at all.
+	ErrEmpty = errors.New("empty amount")
+	// ErrSyntax is reported when the input is not a well-formed number.
+	ErrSyntax = errors.New("invalid syntax")
+	// ErrRange is reported when the amount is too large to be converted exactly.
+	ErrRange = errors.New("amount out of range")
+	// ErrNotFinite is reported for NaN and infinite amounts.
+	ErrNotFinite = errors.New("amount is not a finite number")
+	// ErrTooManyDecimals is reported when the input has more fraction digits
+	// than are allowed.
+	ErrTooManyDecimals = errors.New("too many decimal places")
+	// ErrNegative is reported for negative amounts under NegativeReject.
+	ErrNegative = errors.New("negative amount")
+	// ErrUnknownCurrency is reported for currency codes that are not
+	// registered.
+	ErrUnknownCurrency = errors.New("unknown currency")
+	// ErrUnknownLocale is reported for locale names that are not registered.
+	ErrUnknownLocale = errors.New("unknown locale")
+)
+
+// ParseError records a failed conversion of a string amount.
+type ParseError struct {
+	Input  string // the input as given by the caller
+	Offset int    // byte offset in Input of the offending character
+	Err    error  // one of the sentinel errors, such as ErrSyntax, or an error wrapping it
+	Cause  error  // the underlying error, such as a *strconv.NumError; may be nil
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("invalid number format %q at offset %d: %v", e.Input, e.Offset, e.Err)
+}
+
+// Unwrap returns both the sentinel error and the underlying cause, so that
+// errors.Is matches either of them.
+func (e *ParseError) Unwrap() []error {
+	if e.Cause == nil {
+		return []error{e.Err}
+	}
+	return []error{e.Err, e.Cause}
+}
+
+// leadingSpace returns the length of the white space at the start of s.
+func leadingSpace(s string) int {
+	return len(s) - len(strings.TrimLeftFunc(s, unicode.IsSpace))
+}
+
+// syntaxOffset reports where s stops looking like a number accepted by
+// WordsFromString: an optional sign, digits with commas, an optional fraction
+// and an optional exponent, or one of the words Inf, Infinity and NaN.
+// It returns len(s) when the input ends too early, as in "1e" or "-".
+func syntaxOffset(s string) int {
+	i := leadingSpace(s)
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+
+	for _, word := range []string{"infinity", "inf", "nan"} {
+		if len(s)-i >= len(word) && strings.EqualFold(s[i:i+len(word)], word) {
+			return trailingOffset(s, i+len(word))
+		}
+	}
+
+	digits := 0
+	scanDigits := func() {
+		for i < len(s) && (isDigit(s[i]) || s[i] == ',') {
+			if s[i] != ',' {
+				digits++
+			}
+			i++
+		}
+	}
+
+	scanDigits()
+	if i < len(s) && s[i] == '.' {
+		i++
+		scanDigits()
+	}
+	if digits == 0 {
+		return i
+	}
+
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		i++
+		if i < len(s) && (s[i] == '+' || s[i] == '-') {
+			i++
+		}
+		start := i
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+		if i == start {
+			return i
+		}
+	}
+
+	return trailingOffset(s, i)
+}
+
+// trailingOffset returns the offset of the first character after i that is not
+// trailing white space, or len(s) if there is none.
+func trailingOffset(s string, i int) int {
+	if strings.TrimSpace(s[i:]) == "" {
+		return len(s)
+	}
+	return i
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,92 @@
+package bahttext
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestWordsFromStringErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantErr    error
+		wantOffset int
+	}{
+		{"empty", "", ErrEmpty, 0},
+		{"only-spaces", "   ", ErrEmpty, 0},
+		{"only-commas", ",,", ErrEmpty, 0},
+		{"letters", "abc", ErrSyntax, 0},
+		{"bad-character", "123,45@7", ErrSyntax, 6},
+		{"bad-character-after-spaces", "  12x", ErrSyntax, 4},
+		{"multiple-dots", "12.34.56", ErrSyntax, 5},
+		{"lone-sign", "-", ErrSyntax, 1},
+		{"missing-exponent", "1e", ErrSyntax, 2},
+		{"inner-space", "12 34", ErrSyntax, 2},
+		{"overflow", " 1e400", ErrRange, 1},
+		{"beyond-exact", "1e20", ErrRange, 0},
+		{"nan", "NaN", ErrNotFinite, 0},
+		{"infinity", " -Infinity", ErrNotFinite, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := WordsFromString(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("WordsFromString(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			}
+
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("WordsFromString(%q) error = %T, want *ParseError", tt.input, err)
+			}
+			if perr.Input != tt.input {
+				t.Errorf("ParseError.Input = %q, want %q", perr.Input, tt.input)
+			}
+			if perr.Offset != tt.wantOffset {
+				t.Errorf("ParseError.Offset = %d, want %d", perr.Offset, tt.wantOffset)
+			}
+		})
+	}
+}
+
+func TestParseErrorCause(t *testing.T) {
+	_, err := WordsFromString("12@34")
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("WordsFromString(%q) error = %v, want it to wrap strconv.ErrSyntax", "12@34", err)
+	}
+
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) {
+		t.Errorf("WordsFromString(%q) error = %v, want it to wrap *strconv.NumError", "12@34", err)
+	}
+
+	want := `invalid number format "12@34" at offset 2: invalid syntax`
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestWordsESentinels(t *testing.T) {
+	if _, err := WordsE(math.Inf(1)); !errors.Is(err, ErrNotFinite) {
+		t.Errorf("WordsE(+Inf) error = %v, want ErrNotFinite", err)
+	}
+	if _, err := WordsE(1e300); !errors.Is(err, ErrRange) {
+		t.Errorf("WordsE(1e300) error = %v, want ErrRange", err)
+	}
+}
+
+func TestMustWordsFromStringPanicsWithParseError(t *testing.T) {
+	defer func() {
+		err, ok := recover().(error)
+		if !ok {
+			t.Fatalf("MustWordsFromString should panic with an error")
+		}
+		var perr *ParseError
+		if !errors.As(err, &perr) || !errors.Is(err, ErrSyntax) {
+			t.Errorf("MustWordsFromString panicked with %v, want a *ParseError wrapping ErrSyntax", err)
+		}
+	}()
+	MustWordsFromString("invalid")
+}
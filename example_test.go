@@ -2,6 +2,8 @@ package bahttext_test
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 
 	"github.com/anuchito/bahttext"
 )
@@ -143,3 +145,350 @@ func ExampleMustWordsFromString() {
 	fmt.Println(Words)
 	// Output: หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์
 }
+
+// ExampleWordsSatang demonstrates converting an exact amount stored in satang
+func ExampleWordsSatang() {
+	Words := bahttext.WordsSatang(123456)
+	fmt.Println(Words)
+	// Output: หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์
+}
+
+// ExampleWordsScaled demonstrates converting a CURRENCY-style amount scaled by 10^4
+func ExampleWordsScaled() {
+	Words := bahttext.WordsScaled(12345678, 4)
+	fmt.Println(Words)
+	// Output: หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบเจ็ดสตางค์
+}
+
+// ExampleWordsBigInt demonstrates converting an amount beyond the uint64 range
+func ExampleWordsBigInt() {
+	money, _ := new(big.Int).SetString("1000000000000000000000000", 10)
+	Words := bahttext.WordsBigInt(money)
+	fmt.Println(Words)
+	// Output: หนึ่งล้านล้านล้านล้านบาทถ้วน
+}
+
+// ExampleWordsBigRat demonstrates converting an exact rational amount
+func ExampleWordsBigRat() {
+	money, _ := new(big.Rat).SetString("1234.565")
+	Words := bahttext.WordsBigRat(money)
+	fmt.Println(Words)
+	// Output: หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบเจ็ดสตางค์
+}
+
+// ExampleWordsE demonstrates rejecting amounts that cannot be converted exactly
+func ExampleWordsE() {
+	for _, amount := range []float64{1234.56, math.NaN(), 1e20} {
+		Words, err := bahttext.WordsE(amount)
+		if err != nil {
+			fmt.Printf("%v -> Error: %v\n", amount, err)
+			continue
+		}
+		fmt.Printf("%v -> %s\n", amount, Words)
+	}
+	// Output:
+	// 1234.56 -> หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์
+	// NaN -> Error: amount is not a finite number: NaN
+	// 1e+20 -> Error: amount out of range: 1e+20
+}
+
+// ExampleWordsFromStringStrict demonstrates the strict money grammar
+func ExampleWordsFromStringStrict() {
+	for _, amount := range []string{"1,234.56", "1,2,3", "1.234"} {
+		Words, err := bahttext.WordsFromStringStrict(amount)
+		if err != nil {
+			fmt.Printf("%q -> Error: %v\n", amount, err)
+			continue
+		}
+		fmt.Printf("%q -> %s\n", amount, Words)
+	}
+	// Output:
+	// "1,234.56" -> หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์
+	// "1,2,3" -> Error: invalid number format "1,2,3" at offset 3: invalid syntax: thousands separator must be followed by exactly three digits
+	// "1.234" -> Error: invalid number format "1.234" at offset 4: too many decimal places
+}
+
+// ExampleParse demonstrates reading Thai words back into an amount in satang
+func ExampleParse() {
+	satang, err := bahttext.Parse("หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Println(satang)
+	// Output: 123456
+}
+
+// ExampleWordsFromStringRounded demonstrates rounding sub-satang input with different modes
+func ExampleWordsFromStringRounded() {
+	modes := []bahttext.RoundingMode{bahttext.RoundHalfAwayFromZero, bahttext.RoundHalfEven, bahttext.RoundTruncate}
+
+	for _, mode := range modes {
+		Words, err := bahttext.WordsFromStringRounded("1.225", mode)
+		if err != nil {
+			fmt.Printf("%v -> Error: %v\n", mode, err)
+			continue
+		}
+		fmt.Printf("%v -> %s\n", mode, Words)
+	}
+	// Output:
+	// HalfAwayFromZero -> หนึ่งบาทยี่สิบสามสตางค์
+	// HalfEven -> หนึ่งบาทยี่สิบสองสตางค์
+	// Truncate -> หนึ่งบาทยี่สิบสองสตางค์
+}
+
+// ExampleNew demonstrates a Converter with its own options
+func ExampleNew() {
+	c, err := bahttext.New(bahttext.WithRounding(bahttext.RoundHalfEven))
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Println(c.Words(1.005))
+	// Output: หนึ่งบาทถ้วน
+}
+
+// ExampleOf demonstrates converting any Go integer or float type
+func ExampleOf() {
+	var count uint32 = 1234
+	fmt.Println(bahttext.Of(count))
+	fmt.Println(bahttext.Of(uint64(math.MaxUint64)))
+	fmt.Println(bahttext.Of(float32(10.25)))
+	// Output:
+	// หนึ่งพันสองร้อยสามสิบสี่บาทถ้วน
+	// สิบแปดล้านสี่แสนสี่หมื่นหกพันเจ็ดร้อยสี่สิบสี่ล้านเจ็ดหมื่นสามพันเจ็ดร้อยเก้าล้านห้าแสนห้าหมื่นหนึ่งพันหกร้อยสิบห้าบาทถ้วน
+	// สิบบาทยี่สิบห้าสตางค์
+}
+
+// ExampleNumber demonstrates reading a plain number without currency words
+func ExampleNumber() {
+	for _, n := range []int64{101, 121, -10} {
+		fmt.Printf("%d -> %s\n", n, bahttext.Number(n))
+	}
+	// Output:
+	// 101 -> หนึ่งร้อยเอ็ด
+	// 121 -> หนึ่งร้อยยี่สิบเอ็ด
+	// -10 -> ลบสิบ
+}
+
+// ExampleDecimal demonstrates reading a non-currency decimal digit by digit
+func ExampleDecimal() {
+	for _, number := range []string{"3.1415", "0.05", "1.50"} {
+		text, err := bahttext.Decimal(number)
+		if err != nil {
+			fmt.Printf("%s -> Error: %v\n", number, err)
+			continue
+		}
+		fmt.Printf("%s -> %s\n", number, text)
+	}
+	// Output:
+	// 3.1415 -> สามจุดหนึ่งสี่หนึ่งห้า
+	// 0.05 -> ศูนย์จุดศูนย์ห้า
+	// 1.50 -> หนึ่งจุดห้าศูนย์
+}
+
+// ExampleOrdinal demonstrates Thai ordinals with common prefixes
+func ExampleOrdinal() {
+	fmt.Println(bahttext.Ordinal(1, ""))
+	fmt.Println(bahttext.Ordinal(21, bahttext.PrefixOrdinal))
+	fmt.Println(bahttext.Ordinal(3, bahttext.PrefixTime))
+	fmt.Println(bahttext.Ordinal(12, bahttext.PrefixInstallment))
+	// Output:
+	// ที่หนึ่ง
+	// ที่ยี่สิบเอ็ด
+	// ครั้งที่สาม
+	// งวดที่สิบสอง
+}
+
+// ExampleExcelCompatible demonstrates matching Excel's BAHTTEXT() output
+func ExampleExcelCompatible() {
+	c, err := bahttext.New(bahttext.ExcelCompatible())
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Println(c.Words(0.5))
+	fmt.Println(c.Words(-0.001))
+	// Output:
+	// ห้าสิบสตางค์
+	// ศูนย์บาทถ้วน
+}
+
+// ExampleWithReadingStyle demonstrates the Royal Institute reading of a trailing one
+func ExampleWithReadingStyle() {
+	c, err := bahttext.New(bahttext.WithReadingStyle(bahttext.RoyalInstitute))
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Println(c.Words(101))
+	fmt.Println(c.Words(121))
+	// Output:
+	// หนึ่งร้อยหนึ่งบาทถ้วน
+	// หนึ่งร้อยยี่สิบเอ็ดบาทถ้วน
+}
+
+// ExampleOmitLeadingOne demonstrates dropping the leading "หนึ่ง" as in spoken Thai
+func ExampleOmitLeadingOne() {
+	c, err := bahttext.New(bahttext.OmitLeadingOne())
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Println(c.Words(100))
+	fmt.Println(c.Words(1500))
+	fmt.Println(c.Words(1000000))
+	// Output:
+	// ร้อยบาทถ้วน
+	// พันห้าร้อยบาทถ้วน
+	// ล้านบาทถ้วน
+}
+
+// ExampleConverter_With demonstrates changing the words for a single call
+func ExampleConverter_With() {
+	c, err := bahttext.New(bahttext.WithExactSuffix("ตรง"), bahttext.WithSign("ติดลบ"))
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Println(c.Words(-100))
+
+	receipt, err := c.With(bahttext.WithMinorUnit("สต."))
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Println(receipt.Words(1.5))
+	// Output:
+	// ติดลบหนึ่งร้อยบาทตรง
+	// หนึ่งบาทห้าสิบสต.
+}
+
+// ExampleWithNegativeStyle demonstrates presenting a loss in parentheses
+func ExampleWithNegativeStyle() {
+	c, err := bahttext.New(bahttext.WithNegativeStyle(bahttext.NegativeParentheses, bahttext.NegativeZeroUnsigned))
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Println(c.Words(-1000))
+	fmt.Println(c.Words(-0.001))
+	// Output:
+	// (หนึ่งพันบาทถ้วน)
+	// ศูนย์บาทถ้วน
+}
+
+// ExampleWithLanguage demonstrates English readings for bilingual cheques
+func ExampleWithLanguage() {
+	c, err := bahttext.New(bahttext.WithLanguage(bahttext.English))
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Println(c.Words(1234.56))
+
+	cheque, err := c.With(
+		bahttext.EnglishCapitalization(bahttext.CapitalizeTitle),
+		bahttext.EnglishChequeFraction(true),
+		bahttext.EnglishOnly(true),
+	)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Println(cheque.Words(1234.56))
+	// Output:
+	// One thousand two hundred thirty-four baht and fifty-six satang
+	// One Thousand Two Hundred Thirty-Four Baht and 56/100 Only
+}
+
+// ExampleRTGSSeparator demonstrates RTGS romanized readings for ASCII-only channels
+func ExampleRTGSSeparator() {
+	c, err := bahttext.New(bahttext.WithLanguage(bahttext.RTGS))
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Println(c.Words(1200))
+
+	swift, err := c.With(bahttext.RTGSSeparator("-"), bahttext.RTGSCapitalization(bahttext.CapitalizeUpper))
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Println(swift.Words(21.5))
+	// Output:
+	// nueng phan song roi baht thuan
+	// YI-SIP-ET-BAHT-HA-SIP-SATANG
+}
+
+// ExampleWordsCurrency demonstrates reading amounts in other currencies
+func ExampleWordsCurrency() {
+	for _, amount := range []struct {
+		code  string
+		value float64
+	}{{"USD", 100.5}, {"JPY", 1000}, {"KWD", 1.005}} {
+		text, err := bahttext.WordsCurrency(amount.code, amount.value)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			continue
+		}
+		fmt.Println(text)
+	}
+	// Output:
+	// หนึ่งร้อยดอลลาร์สหรัฐห้าสิบเซนต์
+	// หนึ่งพันเยนถ้วน
+	// หนึ่งดีนาร์คูเวตห้าฟิลส์
+}
+
+// Example_chinese demonstrates Chinese financial numerals for invoices
+func Example_chinese() {
+	c, err := bahttext.New(bahttext.WithLanguage(bahttext.Chinese))
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Println(c.Words(1234.56))
+	fmt.Println(c.Words(1001))
+	// Output:
+	// 泰铢壹仟贰佰叁拾肆元伍角陆分
+	// 泰铢壹仟零壹元整
+}
+
+// Example_lao demonstrates Lao kip readings
+func Example_lao() {
+	c, err := bahttext.New(bahttext.WithLanguage(bahttext.Lao))
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Println(c.Words(1200))
+	fmt.Println(c.Words(21.5))
+	// Output:
+	// ໜຶ່ງພັນສອງຮ້ອຍກີບ
+	// ຊາວເອັດກີບຫ້າສິບອັດ
+}
+
+// ExampleWithDialect demonstrates the Isan vocabulary pack
+func ExampleWithDialect() {
+	c, err := bahttext.New(bahttext.WithDialect(bahttext.Isan))
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Println(c.Words(121))
+	// Output: หนึ่งฮ้อยซาวเอ็ดบาทถ้วน
+}
+
+// ExampleTokens demonstrates the parts of a reading
+func ExampleTokens() {
+	for _, token := range bahttext.Tokens(21) {
+		fmt.Println(token.Kind, token.Text, token.Digit, token.Position)
+	}
+	// Output:
+	// Special ยี่ 2 1
+	// Place สิบ 2 1
+	// Special เอ็ด 1 0
+	// Unit บาท -1 0
+	// Suffix ถ้วน -1 0
+}
@@ -0,0 +1,83 @@
+package bahttext
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestExcelCompatibleCorpus(t *testing.T) {
+	c, err := New(ExcelCompatible())
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	file, err := os.Open("testdata/excel_bahttext.tsv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		input, want, ok := strings.Cut(text, "\t")
+		if !ok {
+			t.Fatalf("testdata/excel_bahttext.tsv:%d: missing tab", line)
+		}
+
+		money, err := strconv.ParseFloat(input, 64)
+		if err != nil {
+			t.Fatalf("testdata/excel_bahttext.tsv:%d: %v", line, err)
+		}
+		if got := c.Words(money); got != want {
+			t.Errorf("testdata/excel_bahttext.tsv:%d: Words(%s) = %s, want %s", line, input, got, want)
+		}
+		if got, err := c.WordsFromString(input); err != nil || got != want {
+			t.Errorf("testdata/excel_bahttext.tsv:%d: WordsFromString(%q) = %s, %v, want %s", line, input, got, err, want)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExcelCompatibleDifferences(t *testing.T) {
+	c, err := New(ExcelCompatible())
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		input     float64
+		wantExcel string
+		wantWords string
+	}{
+		{"below-one-baht", 0.5, "ห้าสิบสตางค์", "ศูนย์บาทห้าสิบสตางค์"},
+		{"negative-below-one-baht", -0.25, "ลบยี่สิบห้าสตางค์", "ลบศูนย์บาทยี่สิบห้าสตางค์"},
+		{"negative-rounds-to-zero", -0.001, "ศูนย์บาทถ้วน", "ลบศูนย์บาทถ้วน"},
+		{"same-for-whole-amounts", 101, "หนึ่งร้อยเอ็ดบาทถ้วน", "หนึ่งร้อยเอ็ดบาทถ้วน"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.Words(tt.input); got != tt.wantExcel {
+				t.Errorf("ExcelCompatible Words(%v) = %s, want %s", tt.input, got, tt.wantExcel)
+			}
+			if got := Words(tt.input); got != tt.wantWords {
+				t.Errorf("Words(%v) = %s, want %s", tt.input, got, tt.wantWords)
+			}
+		})
+	}
+
+	if got, _ := c.WordsSatang(-50); got != "ลบห้าสิบสตางค์" {
+		t.Errorf("ExcelCompatible WordsSatang(-50) = %s, want ลบห้าสิบสตางค์", got)
+	}
+}
@@ -183,6 +183,64 @@ func FuzzConsistency(f *testing.F) {
 	})
 }
 
+// FuzzParse tests that Parse reads back exactly the amount Words wrote
+func FuzzParse(f *testing.F) {
+	// Add seed corpus
+	f.Add(0.0)
+	f.Add(0.5)
+	f.Add(21.0)
+	f.Add(10_000_001.0)
+	f.Add(1234.56)
+	f.Add(-1234.56)
+	f.Add(-0.001)
+
+	f.Fuzz(func(t *testing.T, money float64) {
+		// Skip invalid values
+		if math.IsNaN(money) || math.IsInf(money, 0) || math.Abs(money) > 1e12 {
+			t.Skip("Skipping invalid or extremely large values")
+		}
+
+		// Split the amount the same way the Words function does
+		preciseAmount := math.Round(math.Abs(money)*100) / 100
+		wholeBaht := math.Trunc(preciseAmount)
+		satang := int64(wholeBaht)*100 + int64(math.Round((preciseAmount-wholeBaht)*100))
+		if money < 0 {
+			satang = -satang
+		}
+
+		text := Words(money)
+		result, err := Parse(text)
+		if err != nil {
+			t.Fatalf("Parse(%q) returned error: %v", text, err)
+		}
+
+		if result != satang {
+			t.Errorf("Round trip failed: Words(%f) = %q, Parse = %d, want %d", money, text, result, satang)
+		}
+	})
+}
+
+// FuzzParseSatang tests that Parse reads back every int64 amount WordsSatang wrote
+func FuzzParseSatang(f *testing.F) {
+	f.Add(int64(0))
+	f.Add(int64(101))
+	f.Add(int64(100_000_000_100))
+	f.Add(int64(math.MaxInt64))
+	f.Add(int64(math.MinInt64))
+
+	f.Fuzz(func(t *testing.T, satang int64) {
+		text := WordsSatang(satang)
+		result, err := Parse(text)
+		if err != nil {
+			t.Fatalf("Parse(%q) returned error: %v", text, err)
+		}
+
+		if result != satang {
+			t.Errorf("Round trip failed: WordsSatang(%d) = %q, Parse = %d", satang, text, result)
+		}
+	})
+}
+
 // FuzzPropertyBasedTesting tests mathematical properties
 func FuzzPropertyBasedTesting(f *testing.F) {
 	f.Add(100.0)
@@ -0,0 +1,43 @@
+package bahttext
+
+import "strconv"
+
+// Integer is the set of Go integer types accepted by Of.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is the set of Go floating-point types accepted by Of.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Numeric is the set of Go number types accepted by Of.
+type Numeric interface {
+	Integer | Float
+}
+
+// Of converts an amount of any Go number type into its Thai word
+// representation. Integers are whole baht and are converted exactly, without
+// passing through float64, so Of(uint64(math.MaxUint64)) and
+// Of(int64(math.MinInt64)) are both read correctly. Floats are converted like
+// Words, including its panic on NaN, Inf and amounts beyond ±2^53.
+//
+// Example usage:
+//
+//	var count uint32 = 1234
+//	text := baht.Of(count)
+//	fmt.Println(text) // Output: หนึ่งพันสองร้อยสามสิบสี่บาทถ้วน
+func Of[T Numeric](money T) string {
+	// Integer division truncates a half to zero; float division does not.
+	var half T = 1
+	if half /= 2; half != 0 {
+		return Words(float64(money))
+	}
+
+	if money < 0 {
+		return must(std.bahtWords(true, strconv.FormatUint(absInt64(int64(money)), 10), 0))
+	}
+	return must(std.bahtWords(false, strconv.FormatUint(uint64(money), 10), 0))
+}
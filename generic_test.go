@@ -0,0 +1,52 @@
+package bahttext
+
+import (
+	"math"
+	"testing"
+)
+
+type satangCount int64
+
+type price float32
+
+func TestOf(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"int", Of(1234), "หนึ่งพันสองร้อยสามสิบสี่บาทถ้วน"},
+		{"int8", Of(int8(-21)), "ลบยี่สิบเอ็ดบาทถ้วน"},
+		{"int16", Of(int16(101)), "หนึ่งร้อยเอ็ดบาทถ้วน"},
+		{"int32", Of(int32(10_000_001)), "สิบล้านเอ็ดบาทถ้วน"},
+		{"int64", Of(int64(1_000_000_000_000)), "หนึ่งล้านล้านบาทถ้วน"},
+		{"uint", Of(uint(0)), "ศูนย์บาทถ้วน"},
+		{"uint8", Of(uint8(255)), "สองร้อยห้าสิบห้าบาทถ้วน"},
+		{"uint16", Of(uint16(65535)), "หกหมื่นห้าพันห้าร้อยสามสิบห้าบาทถ้วน"},
+		{"uint32", Of(uint32(4_294_967_295)), "สี่พันสองร้อยเก้าสิบสี่ล้านเก้าแสนหกหมื่นเจ็ดพันสองร้อยเก้าสิบห้าบาทถ้วน"},
+		{"max-int64", Of(int64(math.MaxInt64)), "เก้าล้านสองแสนสองหมื่นสามพันสามร้อยเจ็ดสิบสองล้านสามหมื่นหกพันแปดร้อยห้าสิบสี่ล้านเจ็ดแสนเจ็ดหมื่นห้าพันแปดร้อยเจ็ดบาทถ้วน"},
+		{"min-int64", Of(int64(math.MinInt64)), "ลบเก้าล้านสองแสนสองหมื่นสามพันสามร้อยเจ็ดสิบสองล้านสามหมื่นหกพันแปดร้อยห้าสิบสี่ล้านเจ็ดแสนเจ็ดหมื่นห้าพันแปดร้อยแปดบาทถ้วน"},
+		{"max-uint64", Of(uint64(math.MaxUint64)), "สิบแปดล้านสี่แสนสี่หมื่นหกพันเจ็ดร้อยสี่สิบสี่ล้านเจ็ดหมื่นสามพันเจ็ดร้อยเก้าล้านห้าแสนห้าหมื่นหนึ่งพันหกร้อยสิบห้าบาทถ้วน"},
+		{"beyond-float64-precision", Of(int64(9_007_199_254_740_993)), "เก้าพันเจ็ดล้านหนึ่งแสนเก้าหมื่นเก้าพันสองร้อยห้าสิบสี่ล้านเจ็ดแสนสี่หมื่นเก้าร้อยเก้าสิบสามบาทถ้วน"},
+		{"named-int", Of(satangCount(-100)), "ลบหนึ่งร้อยบาทถ้วน"},
+		{"float32", Of(float32(10.25)), "สิบบาทยี่สิบห้าสตางค์"},
+		{"float64", Of(1234.56), "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์"},
+		{"named-float", Of(price(0.5)), "ศูนย์บาทห้าสิบสตางค์"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("Of() = %s, want %s", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOfMatchesWords(t *testing.T) {
+	for n := int64(-2_000); n <= 2_000; n++ {
+		if got, want := Of(n), Words(float64(n)); got != want {
+			t.Errorf("Of(%d) = %s, want %s", n, got, want)
+		}
+	}
+}
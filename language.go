@@ -0,0 +1,128 @@
+package bahttext
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Language selects the language amounts are read in.
+type Language int
+
+const (
+	// Thai reads amounts in Thai, the default.
+	Thai Language = iota
+	// English reads amounts in English, as on bilingual cheques and
+	// invoices: 1234.56 reads "One thousand two hundred thirty-four baht and
+	// fifty-six satang". The English options change the details.
+	English
+	// RTGS reads amounts in Thai romanized with the Royal Thai General
+	// System of Transcription, for channels that accept only ASCII: 1200
+	// reads "nueng phan song roi baht thuan". The RTGS options change the
+	// word separator and the letter case.
+	RTGS
+	// Chinese reads amounts in Chinese financial numerals, as on invoices
+	// for Thai-Chinese trading partners: 1234.56 reads
+	// "泰铢壹仟贰佰叁拾肆元伍角陆分". The major unit word is the currency
+	// name written before the amount.
+	Chinese
+	// Lao reads amounts in Lao kip with the Thai place system, reading 20 as
+	// ຊາວ: 1200 reads "ໜຶ່ງພັນສອງຮ້ອຍກີບ". Whole amounts have no suffix.
+	Lao
+)
+
+func (l Language) String() string {
+	switch l {
+	case Thai:
+		return "Thai"
+	case English:
+		return "English"
+	case RTGS:
+		return "RTGS"
+	case Chinese:
+		return "Chinese"
+	case Lao:
+		return "Lao"
+	}
+	return "Language(" + strconv.Itoa(int(l)) + ")"
+}
+
+// Capitalization selects the letter case of English and RTGS readings.
+type Capitalization int
+
+const (
+	// CapitalizeSentence capitalizes the first word only.
+	CapitalizeSentence Capitalization = iota
+	// CapitalizeTitle capitalizes every word but "and", including each
+	// part of a hyphenated word: "One Thousand Baht and Fifty-Six Satang".
+	CapitalizeTitle
+	// CapitalizeUpper writes every letter in upper case.
+	CapitalizeUpper
+	// CapitalizeLower writes every letter in lower case.
+	CapitalizeLower
+)
+
+// valid reports an error for an unknown Capitalization.
+func (c Capitalization) valid() error {
+	if c < CapitalizeSentence || c > CapitalizeLower {
+		return fmt.Errorf("invalid capitalization %d", int(c))
+	}
+	return nil
+}
+
+// WithLanguage makes the Converter read in lang. It also resets the sign,
+// unit, suffix, zero, decimal point and annotation words to those of lang, so
+// options changing these words must come after it. Every reading follows the
+// language, including Number and Decimal, but only amounts read in baht are
+// capitalized and follow the NegativeStyle. It returns an error after
+// WithCurrency, whose Thai unit names it would replace.
+func WithLanguage(lang Language) Option {
+	return func(c *Converter) error {
+		if lang < Thai || lang > Lao {
+			return fmt.Errorf("invalid language %d", int(lang))
+		}
+		if c.currency != "" {
+			return fmt.Errorf("language %v set after currency %s", lang, c.currency)
+		}
+		c.language, c.custom = lang, nil
+		c.setUnits(c.speller().Units())
+		return nil
+	}
+}
+
+// capitalize applies capitalization to text.
+func capitalize(text string, capitalization Capitalization) string {
+	switch capitalization {
+	case CapitalizeUpper:
+		return strings.ToUpper(text)
+	case CapitalizeLower:
+		return strings.ToLower(text)
+	case CapitalizeTitle:
+		words := strings.Split(text, " ")
+		for i, word := range words {
+			if i > 0 && word == "and" {
+				continue
+			}
+			parts := strings.Split(word, "-")
+			for j, part := range parts {
+				parts[j] = upperFirst(part)
+			}
+			words[i] = strings.Join(parts, "-")
+		}
+		return strings.Join(words, " ")
+	}
+	return upperFirst(text)
+}
+
+// upperFirst returns s with its first letter in upper case, skipping any
+// leading punctuation such as an opening parenthesis.
+func upperFirst(s string) string {
+	for i, r := range s {
+		if unicode.IsLetter(r) {
+			return s[:i] + string(unicode.ToUpper(r)) + s[i+utf8.RuneLen(r):]
+		}
+	}
+	return s
+}
@@ -0,0 +1,20 @@
+package bahttext
+
+// lao is the Lao reading. It has no word for ten thousand and counts tens of
+// thousands instead, as in ສິບພັນ and ຊາວເອັດພັນ, and it uses ຊາວ for two in
+// the tens place.
+var lao = thaiScript{
+	digits: []string{"", "ໜຶ່ງ", "ສອງ", "ສາມ", "ສີ່", "ຫ້າ", "ຫົກ", "ເຈັດ", "ແປດ", "ເກົ້າ"},
+	places: []string{"", "ສິບ", "ຮ້ອຍ", "ພັນ", "", "ແສນ", "ລ້ານ"},
+	twenty: []string{"ຊາວ"},
+	et:     "ເອັດ",
+	units: Units{
+		Sign:       "ລົບ",
+		Major:      "ກີບ",
+		Minor:      "ອັດ",
+		Zero:       "ສູນ",
+		Point:      "ຈຸດ",
+		Annotation: "(ຂາດທຶນ)",
+	},
+	tenThousands: true,
+}
@@ -0,0 +1,148 @@
+package bahttext
+
+import "testing"
+
+func TestLaoKipWords(t *testing.T) {
+	c, err := New(WithLanguage(Lao))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		input float64
+		want  string
+	}{
+		// Zero and Single Digits
+		{"zero", 0, "ສູນກີບ"},
+		{"one", 1, "ໜຶ່ງກີບ"},
+		{"two", 2, "ສອງກີບ"},
+		{"three", 3, "ສາມກີບ"},
+		{"four", 4, "ສີ່ກີບ"},
+		{"five", 5, "ຫ້າກີບ"},
+		{"six", 6, "ຫົກກີບ"},
+		{"seven", 7, "ເຈັດກີບ"},
+		{"eight", 8, "ແປດກີບ"},
+		{"nine", 9, "ເກົ້າກີບ"},
+
+		// Tens
+		{"ten", 10, "ສິບກີບ"},
+		{"eleven", 11, "ສິບເອັດກີບ"},
+		{"fifteen", 15, "ສິບຫ້າກີບ"},
+		{"twenty", 20, "ຊາວກີບ"},
+		{"twenty-one", 21, "ຊາວເອັດກີບ"},
+		{"twenty-two", 22, "ຊາວສອງກີບ"},
+		{"twenty-five", 25, "ຊາວຫ້າກີບ"},
+		{"twenty-nine", 29, "ຊາວເກົ້າກີບ"},
+		{"thirty", 30, "ສາມສິບກີບ"},
+		{"thirty-one", 31, "ສາມສິບເອັດກີບ"},
+		{"forty-one", 41, "ສີ່ສິບເອັດກີບ"},
+		{"fifty-five", 55, "ຫ້າສິບຫ້າກີບ"},
+		{"sixty-one", 61, "ຫົກສິບເອັດກີບ"},
+		{"seventy", 70, "ເຈັດສິບກີບ"},
+		{"eighty-one", 81, "ແປດສິບເອັດກີບ"},
+		{"ninety-nine", 99, "ເກົ້າສິບເກົ້າກີບ"},
+
+		// Hundreds
+		{"one-hundred", 100, "ໜຶ່ງຮ້ອຍກີບ"},
+		{"one-hundred-one", 101, "ໜຶ່ງຮ້ອຍເອັດກີບ"},
+		{"one-hundred-two", 102, "ໜຶ່ງຮ້ອຍສອງກີບ"},
+		{"one-hundred-ten", 110, "ໜຶ່ງຮ້ອຍສິບກີບ"},
+		{"one-hundred-eleven", 111, "ໜຶ່ງຮ້ອຍສິບເອັດກີບ"},
+		{"one-hundred-twenty", 120, "ໜຶ່ງຮ້ອຍຊາວກີບ"},
+		{"one-hundred-twenty-three", 123, "ໜຶ່ງຮ້ອຍຊາວສາມກີບ"},
+		{"one-hundred-ninety-nine", 199, "ໜຶ່ງຮ້ອຍເກົ້າສິບເກົ້າກີບ"},
+		{"two-hundred", 200, "ສອງຮ້ອຍກີບ"},
+		{"two-hundred-twenty-one", 221, "ສອງຮ້ອຍຊາວເອັດກີບ"},
+		{"five-hundred-five", 505, "ຫ້າຮ້ອຍຫ້າກີບ"},
+		{"nine-hundred-ninety-nine", 999, "ເກົ້າຮ້ອຍເກົ້າສິບເກົ້າກີບ"},
+
+		// Thousands
+		{"one-thousand", 1000, "ໜຶ່ງພັນກີບ"},
+		{"one-thousand-one", 1001, "ໜຶ່ງພັນເອັດກີບ"},
+		{"one-thousand-ten", 1010, "ໜຶ່ງພັນສິບກີບ"},
+		{"one-thousand-one-hundred", 1100, "ໜຶ່ງພັນໜຶ່ງຮ້ອຍກີບ"},
+		{"one-thousand-two-hundred", 1200, "ໜຶ່ງພັນສອງຮ້ອຍກີບ"},
+		{"two-thousand-five-hundred", 2500, "ສອງພັນຫ້າຮ້ອຍກີບ"},
+		{"nine-thousand-nine-hundred-ninety-nine", 9999, "ເກົ້າພັນເກົ້າຮ້ອຍເກົ້າສິບເກົ້າກີບ"},
+		{"nine-thousand-twelve-and-thirty-four", 9012.34, "ເກົ້າພັນສິບສອງກີບສາມສິບສີ່ອັດ"},
+
+		// Thousands, Millions, and Billions
+		{"ten-thousand", 10_000, "ສິບພັນກີບ"},
+		{"eleven-thousand", 11_000, "ສິບເອັດພັນກີບ"},
+		{"twenty-one-thousand", 21_000, "ຊາວເອັດພັນກີບ"},
+		{"fifty-thousand", 50_000, "ຫ້າສິບພັນກີບ"},
+		{"fifty-thousand-five", 50_005, "ຫ້າສິບພັນຫ້າກີບ"},
+		{"one-hundred-thousand", 100_000, "ໜຶ່ງແສນກີບ"},
+		{"one-hundred-one-thousand", 101_000, "ໜຶ່ງແສນໜຶ່ງພັນກີບ"},
+		{"one-hundred-fifty-thousand", 150_000, "ໜຶ່ງແສນຫ້າສິບພັນກີບ"},
+		{"one-hundred-twenty-three-thousand-four-hundred-fifty-six", 123_456, "ໜຶ່ງແສນຊາວສາມພັນສີ່ຮ້ອຍຫ້າສິບຫົກກີບ"},
+		{"one-million", 1_000_000, "ໜຶ່ງລ້ານກີບ"},
+		{"ten-million", 10_000_000, "ສິບລ້ານກີບ"},
+		{"twenty-million", 20_000_000, "ຊາວລ້ານກີບ"},
+		{"one-billion", 1_000_000_000, "ໜຶ່ງພັນລ້ານກີບ"},
+		{"large-number", 1_234_567_890, "ໜຶ່ງພັນສອງຮ້ອຍສາມສິບສີ່ລ້ານຫ້າແສນຫົກສິບເຈັດພັນແປດຮ້ອຍເກົ້າສິບກີບ"},
+
+		// Some Trillions and Beyond
+		{"ten-billion", 10_000_000_000, "ສິບພັນລ້ານກີບ"},
+		{"one-trillion", 1_000_000_000_000, "ໜຶ່ງລ້ານລ້ານກີບ"},
+
+		// Floating-Point Numbers (with Att)
+		{"zero-kip-twenty-five-att", 0.25, "ສູນກີບຊາວຫ້າອັດ"},
+		{"zero-kip-fifty-att", 0.50, "ສູນກີບຫ້າສິບອັດ"},
+		{"five-kip-seventy-five-att", 5.75, "ຫ້າກີບເຈັດສິບຫ້າອັດ"},
+		{"one-kip-one-att", 1.01, "ໜຶ່ງກີບໜຶ່ງອັດ"},
+		{"one-kip-twenty-one-att", 1.21, "ໜຶ່ງກີບຊາວເອັດອັດ"},
+		{"negative-rounding", -51.995, "ລົບຫ້າສິບສອງກີບ"},
+		{"negative-att", -51.99, "ລົບຫ້າສິບເອັດກີບເກົ້າສິບເກົ້າອັດ"},
+
+		// Edge Cases & Special Combinations
+		{"negative-one-hundred", -100, "ລົບໜຶ່ງຮ້ອຍກີບ"},
+		{"ten-million-one", 10_000_001, "ສິບລ້ານເອັດກີບ"},
+		{"one-kip-rounded-att", 1.234, "ໜຶ່ງກີບຊາວສາມອັດ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.Words(tt.input); got != tt.want {
+				t.Errorf("Lao Words(%v) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLaoOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  []Option
+		input float64
+		want  string
+	}{
+		{"exact-suffix", []Option{WithExactSuffix("ຖ້ວນ")}, 1200, "ໜຶ່ງພັນສອງຮ້ອຍກີບຖ້ວນ"},
+		{"royal", []Option{WithReadingStyle(RoyalInstitute)}, 101, "ໜຶ່ງຮ້ອຍໜຶ່ງກີບ"},
+		{"omit-leading-one", []Option{OmitLeadingOne()}, 1000, "ພັນກີບ"},
+		{"omit-leading-one-ten-thousand", []Option{OmitLeadingOne()}, 10_000, "ສິບພັນກີບ"},
+		{"royal-thousands", []Option{WithReadingStyle(RoyalInstitute)}, 11_001, "ສິບເອັດພັນໜຶ່ງກີບ"},
+		{"parentheses", []Option{WithNegativeStyle(NegativeParentheses, NegativeZeroSigned)}, -20, "(ຊາວກີບ)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(append([]Option{WithLanguage(Lao)}, tt.opts...)...)
+			if err != nil {
+				t.Fatalf("New() unexpected error: %v", err)
+			}
+			if got := c.Words(tt.input); got != tt.want {
+				t.Errorf("Lao Words(%v) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	c, err := New(WithLanguage(Lao))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	if got, want := c.Number(-21), "ລົບຊາວເອັດ"; got != want {
+		t.Errorf("Lao Number(-21) = %s, want %s", got, want)
+	}
+}
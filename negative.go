@@ -0,0 +1,100 @@
+package bahttext
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// NegativeStyle selects how a negative amount is presented.
+type NegativeStyle int
+
+const (
+	// NegativePrefix starts the reading with the sign word, "ลบ" unless
+	// changed with WithSign: -1000 reads "ลบหนึ่งพันบาทถ้วน".
+	NegativePrefix NegativeStyle = iota
+	// NegativeParentheses wraps the reading in parentheses, as financial
+	// statements show losses: -1000 reads "(หนึ่งพันบาทถ้วน)".
+	NegativeParentheses
+	// NegativeAnnotation follows the reading with an annotation,
+	// "(ขาดทุน)" unless changed with WithNegativeAnnotation: -1000 reads
+	// "หนึ่งพันบาทถ้วน(ขาดทุน)".
+	NegativeAnnotation
+	// NegativeReject makes negative amounts an error wrapping ErrNegative,
+	// as a cheque cannot be written for one. The package-level style of
+	// panicking on error means Converter.Words panics instead.
+	NegativeReject
+)
+
+var negativeStyleNames = []string{"Prefix", "Parentheses", "Annotation", "Reject"}
+
+func (s NegativeStyle) String() string {
+	if s < 0 || int(s) >= len(negativeStyleNames) {
+		return "NegativeStyle(" + strconv.Itoa(int(s)) + ")"
+	}
+	return negativeStyleNames[s]
+}
+
+// NegativeZero selects how a negative amount that rounds to zero, such as
+// -0.001, is presented.
+type NegativeZero int
+
+const (
+	// NegativeZeroSigned presents it like any other negative amount:
+	// -0.001 reads "ลบศูนย์บาทถ้วน", and NegativeReject rejects it.
+	NegativeZeroSigned NegativeZero = iota
+	// NegativeZeroUnsigned drops the sign: -0.001 reads "ศูนย์บาทถ้วน",
+	// as Excel's BAHTTEXT() does, and NegativeReject accepts it.
+	NegativeZeroUnsigned
+)
+
+// WithNegativeStyle makes the Converter present negative amounts in style,
+// and negative amounts that round to zero according to zero. It applies to
+// amounts read in baht; Number and Ordinal keep the sign word prefix.
+func WithNegativeStyle(style NegativeStyle, zero NegativeZero) Option {
+	return func(c *Converter) error {
+		if style < 0 || int(style) >= len(negativeStyleNames) {
+			return fmt.Errorf("invalid negative style %d", int(style))
+		}
+		if zero != NegativeZeroSigned && zero != NegativeZeroUnsigned {
+			return fmt.Errorf("invalid negative zero %d", int(zero))
+		}
+		c.negative = style
+		c.negativeZero = zero
+		return nil
+	}
+}
+
+// WithNegativeAnnotation sets the annotation that NegativeAnnotation writes
+// after a negative amount, for example "(ติดลบ)". It does not select the
+// style by itself.
+func WithNegativeAnnotation(word string) Option {
+	return func(c *Converter) error {
+		if err := checkWord("negative annotation", word); err != nil {
+			return err
+		}
+		c.annotation = word
+		return nil
+	}
+}
+
+// signed presents tokens, the reading of an amount's magnitude, as negative
+// according to c's negative style.
+func (c *Converter) signed(tokens []Token) ([]Token, error) {
+	var space []Token
+	if sep := c.separator(); sep != "" {
+		space = []Token{word(sep, TokenSeparator)}
+	}
+
+	switch c.negative {
+	case NegativeParentheses:
+		tokens = append([]Token{word("(", TokenSign)}, tokens...)
+		return append(tokens, word(")", TokenSign)), nil
+	case NegativeAnnotation:
+		tokens = append(tokens, space...)
+		return append(tokens, word(c.annotation, TokenSign)), nil
+	case NegativeReject:
+		return nil, ErrNegative
+	}
+	signed := append([]Token{word(c.minus, TokenSign)}, space...)
+	return append(signed, tokens...), nil
+}
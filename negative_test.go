@@ -0,0 +1,141 @@
+package bahttext
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestNegativeStyle(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  []Option
+		input float64
+		want  string
+	}{
+		{"prefix", []Option{WithNegativeStyle(NegativePrefix, NegativeZeroSigned)}, -1000, "ลบหนึ่งพันบาทถ้วน"},
+		{"prefix-sign-word", []Option{WithNegativeStyle(NegativePrefix, NegativeZeroSigned), WithSign("ติดลบ")}, -1000, "ติดลบหนึ่งพันบาทถ้วน"},
+		{"parentheses", []Option{WithNegativeStyle(NegativeParentheses, NegativeZeroSigned)}, -1000, "(หนึ่งพันบาทถ้วน)"},
+		{"parentheses-satang", []Option{WithNegativeStyle(NegativeParentheses, NegativeZeroSigned)}, -1.5, "(หนึ่งบาทห้าสิบสตางค์)"},
+		{"annotation", []Option{WithNegativeStyle(NegativeAnnotation, NegativeZeroSigned)}, -1000, "หนึ่งพันบาทถ้วน(ขาดทุน)"},
+		{"annotation-word", []Option{WithNegativeStyle(NegativeAnnotation, NegativeZeroSigned), WithNegativeAnnotation("(ติดลบ)")}, -1000, "หนึ่งพันบาทถ้วน(ติดลบ)"},
+		{"positive-unchanged", []Option{WithNegativeStyle(NegativeParentheses, NegativeZeroSigned)}, 1000, "หนึ่งพันบาทถ้วน"},
+		{"reject-positive", []Option{WithNegativeStyle(NegativeReject, NegativeZeroSigned)}, 1000, "หนึ่งพันบาทถ้วน"},
+
+		// Amounts that round to zero from the negative side
+		{"zero-signed", []Option{WithNegativeStyle(NegativePrefix, NegativeZeroSigned)}, -0.001, "ลบศูนย์บาทถ้วน"},
+		{"zero-unsigned", []Option{WithNegativeStyle(NegativePrefix, NegativeZeroUnsigned)}, -0.001, "ศูนย์บาทถ้วน"},
+		{"zero-parentheses", []Option{WithNegativeStyle(NegativeParentheses, NegativeZeroSigned)}, -0.001, "(ศูนย์บาทถ้วน)"},
+		{"zero-reject-unsigned", []Option{WithNegativeStyle(NegativeReject, NegativeZeroUnsigned)}, -0.001, "ศูนย์บาทถ้วน"},
+		{"excel-parentheses", []Option{ExcelCompatible(), WithNegativeStyle(NegativeParentheses, NegativeZeroUnsigned)}, -0.5, "(ห้าสิบสตางค์)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(tt.opts...)
+			if err != nil {
+				t.Fatalf("New() unexpected error: %v", err)
+			}
+			got, err := c.WordsE(tt.input)
+			if err != nil {
+				t.Fatalf("WordsE(%v) unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("WordsE(%v) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNegativeReject(t *testing.T) {
+	c, err := New(WithNegativeStyle(NegativeReject, NegativeZeroSigned))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	if _, err := c.WordsE(-1000); !errors.Is(err, ErrNegative) {
+		t.Errorf("WordsE(-1000) error = %v, want ErrNegative", err)
+	}
+	if _, err := c.WordsE(-0.001); !errors.Is(err, ErrNegative) {
+		t.Errorf("WordsE(-0.001) error = %v, want ErrNegative", err)
+	}
+	if _, err := c.WordsSatang(-1); !errors.Is(err, ErrNegative) {
+		t.Errorf("WordsSatang(-1) error = %v, want ErrNegative", err)
+	}
+	if _, err := c.WordsFromString("-1,000.50"); !errors.Is(err, ErrNegative) {
+		t.Errorf("WordsFromString(-1,000.50) error = %v, want ErrNegative", err)
+	}
+	if _, err := c.WordsBigInt(big.NewInt(-5)); !errors.Is(err, ErrNegative) {
+		t.Errorf("WordsBigInt(-5) error = %v, want ErrNegative", err)
+	}
+	if _, err := c.WordsBigRat(big.NewRat(-1, 3)); !errors.Is(err, ErrNegative) {
+		t.Errorf("WordsBigRat(-1/3) error = %v, want ErrNegative", err)
+	}
+
+	messages := []struct {
+		currency string
+		input    float64
+		want     string
+	}{
+		{"THB", -0.05, "negative amount: -0.05"},
+		{"KWD", -1.005, "negative amount: -1.005"},
+		{"KWD", -2, "negative amount: -2.000"},
+		{"JPY", -1000, "negative amount: -1000"},
+	}
+	for _, tt := range messages {
+		cc, err := c.With(WithCurrency(tt.currency))
+		if err != nil {
+			t.Fatalf("WithCurrency(%s) unexpected error: %v", tt.currency, err)
+		}
+		_, err = cc.WordsE(tt.input)
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("%s WordsE(%v) error = %v, want %s", tt.currency, tt.input, err, tt.want)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Words(-1) expected panic")
+		}
+	}()
+	c.Words(-1)
+}
+
+func TestNegativeStyleInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  Option
+	}{
+		{"style", WithNegativeStyle(NegativeStyle(9), NegativeZeroSigned)},
+		{"negative-style", WithNegativeStyle(NegativeStyle(-1), NegativeZeroSigned)},
+		{"zero", WithNegativeStyle(NegativePrefix, NegativeZero(2))},
+		{"annotation", WithNegativeAnnotation("")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(tt.opt); err == nil {
+				t.Errorf("New() expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestNegativeStyleString(t *testing.T) {
+	tests := []struct {
+		style NegativeStyle
+		want  string
+	}{
+		{NegativePrefix, "Prefix"},
+		{NegativeParentheses, "Parentheses"},
+		{NegativeAnnotation, "Annotation"},
+		{NegativeReject, "Reject"},
+		{NegativeStyle(7), "NegativeStyle(7)"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.style.String(); got != tt.want {
+			t.Errorf("NegativeStyle(%d).String() = %s, want %s", int(tt.style), got, tt.want)
+		}
+	}
+}
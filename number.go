@@ -0,0 +1,41 @@
+package bahttext
+
+import "strconv"
+
+// Number converts an integer into its plain Thai cardinal reading, without any
+// currency words, for things such as page counts and quantities. It follows
+// the same rules as Words, so 101 reads "หนึ่งร้อยเอ็ด" and -10 reads "ลบสิบ".
+//
+// Example usage:
+//
+//	text := baht.Number(121)
+//	fmt.Println(text) // Output: หนึ่งร้อยยี่สิบเอ็ด
+func Number(n int64) string {
+	return std.Number(n)
+}
+
+// NumberUint is like Number but accepts the full uint64 range.
+func NumberUint(n uint64) string {
+	return std.NumberUint(n)
+}
+
+// Number is like the package-level Number but uses c's options.
+func (c *Converter) Number(n int64) string {
+	c = c.ready()
+	return c.number(n < 0, strconv.FormatUint(absInt64(n), 10))
+}
+
+// NumberUint is like the package-level NumberUint but uses c's options.
+func (c *Converter) NumberUint(n uint64) string {
+	c = c.ready()
+	return c.number(false, strconv.FormatUint(n, 10))
+}
+
+// number reads the integer given as decimal digits, preceded by the sign word
+// when negative. It is shared by the cardinal and the decimal readings.
+func (c *Converter) number(negative bool, digits string) string {
+	if negative {
+		return c.join(c.minus, c.cardinal(digits))
+	}
+	return c.cardinal(digits)
+}
@@ -0,0 +1,53 @@
+package bahttext
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestNumber(t *testing.T) {
+	tests := []struct {
+		name  string
+		input int64
+		want  string
+	}{
+		{"zero", 0, "ศูนย์"},
+		{"one", 1, "หนึ่ง"},
+		{"ten", 10, "สิบ"},
+		{"eleven", 11, "สิบเอ็ด"},
+		{"twenty-one", 21, "ยี่สิบเอ็ด"},
+		{"one-hundred-one", 101, "หนึ่งร้อยเอ็ด"},
+		{"one-hundred-twenty-one", 121, "หนึ่งร้อยยี่สิบเอ็ด"},
+		{"ten-million-one", 10_000_001, "สิบล้านเอ็ด"},
+		{"one-trillion", 1_000_000_000_000, "หนึ่งล้านล้าน"},
+		{"negative-ten", -10, "ลบสิบ"},
+		{"negative-one", -1, "ลบหนึ่ง"},
+		{"min-int64", math.MinInt64, "ลบเก้าล้านสองแสนสองหมื่นสามพันสามร้อยเจ็ดสิบสองล้านสามหมื่นหกพันแปดร้อยห้าสิบสี่ล้านเจ็ดแสนเจ็ดหมื่นห้าพันแปดร้อยแปด"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Number(tt.input)
+			if result != tt.want {
+				t.Errorf("Number(%d) = %s, want %s", tt.input, result, tt.want)
+			}
+		})
+	}
+}
+
+func TestNumberUint(t *testing.T) {
+	want := "สิบแปดล้านสี่แสนสี่หมื่นหกพันเจ็ดร้อยสี่สิบสี่ล้านเจ็ดหมื่นสามพันเจ็ดร้อยเก้าล้านห้าแสนห้าหมื่นหนึ่งพันหกร้อยสิบห้า"
+	if result := NumberUint(math.MaxUint64); result != want {
+		t.Errorf("NumberUint(MaxUint64) = %s, want %s", result, want)
+	}
+}
+
+func TestNumberMatchesWords(t *testing.T) {
+	for n := int64(-3_000); n <= 3_000; n++ {
+		want := strings.TrimSuffix(Words(float64(n)), "บาทถ้วน")
+		if got := Number(n); got != want {
+			t.Errorf("Number(%d) = %s, want %s", n, got, want)
+		}
+	}
+}
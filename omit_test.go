@@ -0,0 +1,104 @@
+package bahttext
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestOmitLeadingOne(t *testing.T) {
+	c, err := New(OmitLeadingOne())
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		input int64
+		want  string
+	}{
+		{"one", 1, "หนึ่ง"},
+		{"ten", 10, "สิบ"},
+		{"eleven", 11, "สิบเอ็ด"},
+		{"one-hundred", 100, "ร้อย"},
+		{"one-hundred-one", 101, "ร้อยเอ็ด"},
+		{"one-thousand-five-hundred", 1500, "พันห้าร้อย"},
+		{"one-thousand-one-hundred", 1100, "พันหนึ่งร้อย"},
+		{"ten-thousand", 10_000, "หมื่น"},
+		{"one-hundred-thousand", 100_000, "แสน"},
+		{"one-million", 1_000_000, "ล้าน"},
+		{"one-million-one", 1_000_001, "ล้านเอ็ด"},
+		{"one-million-one-hundred-thousand", 1_100_000, "ล้านหนึ่งแสน"},
+		{"ten-million", 10_000_000, "สิบล้าน"},
+		{"one-hundred-million", 100_000_000, "ร้อยล้าน"},
+		{"eleven-million", 11_000_000, "สิบเอ็ดล้าน"},
+		{"two-hundred", 200, "สองร้อย"},
+		{"million-million", 1_000_000_000_000, "ล้านล้าน"},
+		{"million-one-million", 1_000_001_000_000, "ล้านเอ็ดล้าน"},
+		{"hundred-thousand-million", 100_000_000_000, "แสนล้าน"},
+		{"negative-one-hundred", -100, "ลบร้อย"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.Number(tt.input); got != tt.want {
+				t.Errorf("OmitLeadingOne Number(%d) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOmitLeadingOneWords(t *testing.T) {
+	c, err := New(OmitLeadingOne())
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		input float64
+		want  string
+	}{
+		{100, "ร้อยบาทถ้วน"},
+		{1500, "พันห้าร้อยบาทถ้วน"},
+		{1_000_000, "ล้านบาทถ้วน"},
+		{1.01, "หนึ่งบาทหนึ่งสตางค์"},
+		{100.1, "ร้อยบาทสิบสตางค์"},
+	}
+
+	for _, tt := range tests {
+		if got := c.Words(tt.input); got != tt.want {
+			t.Errorf("OmitLeadingOne Words(%v) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+
+	n, _ := new(big.Int).SetString("1000000000000000000", 10)
+	if got, want := must(c.WordsBigInt(n)), "ล้านล้านล้านบาทถ้วน"; got != want {
+		t.Errorf("OmitLeadingOne WordsBigInt(10^18) = %s, want %s", got, want)
+	}
+
+	royal, err := New(OmitLeadingOne(), WithReadingStyle(RoyalInstitute))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	if got, want := royal.Number(101), "ร้อยหนึ่ง"; got != want {
+		t.Errorf("OmitLeadingOne RoyalInstitute Number(101) = %s, want %s", got, want)
+	}
+}
+
+func TestOmitLeadingOneRoundTrip(t *testing.T) {
+	c, err := New(OmitLeadingOne())
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	for _, satang := range []int64{100_00, 1500_00, 1_000_000_00, 1_000_000_000_000_00, 1_100_000_00} {
+		text := must(c.WordsSatang(satang))
+		got, err := Parse(text)
+		if err != nil {
+			t.Errorf("Parse(%q) unexpected error: %v", text, err)
+			continue
+		}
+		if got != satang {
+			t.Errorf("Parse(%q) = %d, want %d", text, got, satang)
+		}
+	}
+}
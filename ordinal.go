@@ -0,0 +1,87 @@
+package bahttext
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Common prefixes for Ordinal.
+const (
+	PrefixOrdinal     = "ที่"      // ที่หนึ่ง: first
+	PrefixTime        = "ครั้งที่" // ครั้งที่สาม: the third time
+	PrefixInstallment = "งวดที่"   // งวดที่สิบสอง: the twelfth installment
+	PrefixClause      = "ข้อ"      // ข้อห้า: clause five
+)
+
+// Ordinal reads n as a Thai ordinal: prefix followed by the cardinal reading
+// of n, as produced by Number. An empty prefix means PrefixOrdinal.
+// It panics if n is less than one, which has no ordinal; OrdinalE returns an
+// error instead.
+//
+// The prefix does not count as a preceding digit, so a lone one stays
+// "หนึ่ง" while a trailing one after other digits is still "เอ็ด":
+//
+//	Ordinal(1, "")                 -> "ที่หนึ่ง"
+//	Ordinal(21, "")                -> "ที่ยี่สิบเอ็ด"
+//	Ordinal(3, PrefixTime)         -> "ครั้งที่สาม"
+//	Ordinal(12, PrefixInstallment) -> "งวดที่สิบสอง"
+func Ordinal(n int64, prefix string) string {
+	return std.Ordinal(n, prefix)
+}
+
+// OrdinalE is like Ordinal but returns an error wrapping ErrRange instead of
+// panicking when n is less than one.
+func OrdinalE(n int64, prefix string) (string, error) {
+	return std.OrdinalE(n, prefix)
+}
+
+// Ordinal is like the package-level Ordinal but uses c's options. Ordinals
+// are read in Thai, even by an English, Chinese or Lao Converter or one with
+// a custom Speller, except that RTGS romanizes them: the common prefixes become "thi", "khrang thi",
+// "nguat thi" and "kho", and other prefixes must already be romanized.
+func (c *Converter) Ordinal(n int64, prefix string) string {
+	return must(c.OrdinalE(n, prefix))
+}
+
+// OrdinalE is like the package-level OrdinalE but uses c's options.
+func (c *Converter) OrdinalE(n int64, prefix string) (string, error) {
+	c = c.ready()
+	if n < 1 {
+		return "", fmt.Errorf("%w: ordinal %d", ErrRange, n)
+	}
+	if prefix == "" {
+		prefix = PrefixOrdinal
+	}
+	if c.language == RTGS && c.custom == nil {
+		return c.rtgsOrdinal(n, prefix)
+	}
+	return prefix + c.thai().Number(n), nil
+}
+
+// thai returns c, or for a language or Speller without ordinals of its own a
+// copy of c that reads Thai with the Thai words.
+func (c *Converter) thai() *Converter {
+	switch {
+	case c.custom != nil:
+	case c.language == English, c.language == Chinese, c.language == Lao:
+	default:
+		return c
+	}
+	t := *c
+	t.language, t.custom = Thai, nil
+	t.setUnits(thai.units)
+	return &t
+}
+
+// rtgsOrdinal reads n as an RTGS ordinal with the romanized prefix.
+func (c *Converter) rtgsOrdinal(n int64, prefix string) (string, error) {
+	words, ok := rtgsPrefixes[prefix]
+	if !ok {
+		if strings.ContainsFunc(prefix, func(r rune) bool { return r > unicode.MaxASCII }) {
+			return "", fmt.Errorf("ordinal prefix %q has no RTGS romanization", prefix)
+		}
+		words = []string{prefix}
+	}
+	return c.join(append(words, c.Number(n))...), nil
+}
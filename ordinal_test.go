@@ -0,0 +1,103 @@
+package bahttext
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestOrdinal(t *testing.T) {
+	tests := []struct {
+		name   string
+		n      int64
+		prefix string
+		want   string
+	}{
+		{"first", 1, "", "ที่หนึ่ง"},
+		{"first-explicit-prefix", 1, PrefixOrdinal, "ที่หนึ่ง"},
+		{"second", 2, "", "ที่สอง"},
+		{"tenth", 10, "", "ที่สิบ"},
+		{"eleventh", 11, "", "ที่สิบเอ็ด"},
+		{"twenty-first", 21, "", "ที่ยี่สิบเอ็ด"},
+		{"one-hundred-first", 101, "", "ที่หนึ่งร้อยเอ็ด"},
+		{"one-millionth", 1_000_000, "", "ที่หนึ่งล้าน"},
+		{"third-time", 3, PrefixTime, "ครั้งที่สาม"},
+		{"first-time", 1, PrefixTime, "ครั้งที่หนึ่ง"},
+		{"twelfth-installment", 12, PrefixInstallment, "งวดที่สิบสอง"},
+		{"clause-one", 1, PrefixClause, "ข้อหนึ่ง"},
+		{"custom-prefix", 5, "ฉบับที่", "ฉบับที่ห้า"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Ordinal(tt.n, tt.prefix)
+			if result != tt.want {
+				t.Errorf("Ordinal(%d, %q) = %s, want %s", tt.n, tt.prefix, result, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrdinalBelowOne(t *testing.T) {
+	for _, n := range []int64{0, -1, -21, math.MinInt64} {
+		if _, err := OrdinalE(n, ""); !errors.Is(err, ErrRange) {
+			t.Errorf("OrdinalE(%d) error = %v, want ErrRange", n, err)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Ordinal(-1) expected panic")
+		}
+	}()
+	Ordinal(-1, "")
+}
+
+func TestOrdinalOtherLanguages(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   []Option
+		n      int64
+		prefix string
+		want   string
+	}{
+		{"english", []Option{WithLanguage(English)}, 3, "", "ที่สาม"},
+		{"english-prefix", []Option{WithLanguage(English)}, 21, PrefixTime, "ครั้งที่ยี่สิบเอ็ด"},
+		{"thai-style-kept", []Option{WithReadingStyle(RoyalInstitute)}, 101, "", "ที่หนึ่งร้อยหนึ่ง"},
+		{"chinese", []Option{WithLanguage(Chinese)}, 1001, "", "ที่หนึ่งพันเอ็ด"},
+		{"lao", []Option{WithLanguage(Lao)}, 20, "", "ที่ยี่สิบ"},
+		{"custom-speller", []Option{WithSpeller(indonesianSpeller{})}, 2, "", "ที่สอง"},
+		{"custom-speller-over-rtgs", []Option{WithLanguage(RTGS), WithSpeller(indonesianSpeller{})}, 2, "", "ที่สอง"},
+		{"dialect-kept", []Option{WithDialect(Isan), WithLanguage(English)}, 121, "", "ที่หนึ่งฮ้อยซาวเอ็ด"},
+		{"dialect", []Option{WithDialect(Northern)}, 21, PrefixTime, "ครั้งที่ซาวเอ็ด"},
+		{"rtgs", []Option{WithLanguage(RTGS)}, 11, "", "thi sip et"},
+		{"rtgs-time", []Option{WithLanguage(RTGS)}, 3, PrefixTime, "khrang thi sam"},
+		{"rtgs-hyphen", []Option{WithLanguage(RTGS), RTGSSeparator("-")}, 12, PrefixInstallment, "nguat-thi-sip-song"},
+		{"rtgs-clause", []Option{WithLanguage(RTGS), RTGSSeparator("")}, 5, PrefixClause, "khoha"},
+		{"rtgs-romanized-prefix", []Option{WithLanguage(RTGS)}, 2, "chabap thi", "chabap thi song"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(tt.opts...)
+			if err != nil {
+				t.Fatalf("New() unexpected error: %v", err)
+			}
+			got, err := c.OrdinalE(tt.n, tt.prefix)
+			if err != nil {
+				t.Fatalf("OrdinalE(%d, %q) unexpected error: %v", tt.n, tt.prefix, err)
+			}
+			if got != tt.want {
+				t.Errorf("OrdinalE(%d, %q) = %s, want %s", tt.n, tt.prefix, got, tt.want)
+			}
+		})
+	}
+
+	c, err := New(WithLanguage(RTGS))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	if got, err := c.OrdinalE(5, "ฉบับที่"); err == nil {
+		t.Errorf("RTGS OrdinalE(5, %q) = %s, want an error", "ฉบับที่", got)
+	}
+}
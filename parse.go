@@ -0,0 +1,283 @@
+package bahttext
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// wordKind classifies the words recognized by Parse.
+type wordKind int
+
+const (
+	wordDigit   wordKind = iota // หนึ่ง to เก้า, and ศูนย์
+	wordYi                      // ยี่, only before สิบ
+	wordEt                      // เอ็ด, a trailing one
+	wordPlace                   // สิบ to แสน
+	wordMillion                 // ล้าน
+	wordMinus                   // ลบ
+	wordBaht                    // บาท
+	wordExact                   // ถ้วน
+	wordSatang                  // สตางค์
+)
+
+// thaiWord is one word of the text Parse reads, with its offset in the input.
+type thaiWord struct {
+	kind   wordKind
+	value  int // the digit for wordDigit, the power of ten for wordPlace
+	offset int
+	text   string
+}
+
+// parseVocabulary lists every word that Words emits.
+var parseVocabulary = func() []thaiWord {
+	words := []thaiWord{
+		{kind: wordDigit, value: 0, text: "ศูนย์"},
+		{kind: wordYi, value: 2, text: "ยี่"},
+		{kind: wordEt, value: 1, text: "เอ็ด"},
+		{kind: wordMillion, value: 6, text: unitPlaces[6]},
+		{kind: wordMinus, text: "ลบ"},
+		{kind: wordBaht, text: "บาท"},
+		{kind: wordExact, text: "ถ้วน"},
+		{kind: wordSatang, text: "สตางค์"},
+	}
+	for digit := 1; digit < len(unitWords); digit++ {
+		words = append(words, thaiWord{kind: wordDigit, value: digit, text: unitWords[digit]})
+	}
+	for place := 1; place < 6; place++ {
+		words = append(words, thaiWord{kind: wordPlace, value: place, text: unitPlaces[place]})
+	}
+	return words
+}()
+
+// Parse reads a Thai amount in words, such as the text produced by Words, and
+// returns it in satang. It understands เอ็ด, ยี่สิบ, chained ล้าน, a leading ลบ,
+// and amounts ending in บาทถ้วน, บาท…สตางค์ or only …สตางค์. White space
+// between words is ignored.
+//
+// Example usage:
+//
+//	satang, err := baht.Parse("หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(satang) // Output: 123456
+//
+// Errors are reported as a *ParseError wrapping ErrEmpty, ErrSyntax or, for
+// amounts beyond the int64 range of satang, ErrRange.
+func Parse(text string) (satang int64, err error) {
+	n, err := ParseBig(text)
+	if err != nil {
+		return 0, err
+	}
+	if !n.IsInt64() {
+		return 0, &ParseError{Input: text, Offset: leadingSpace(text), Err: ErrRange}
+	}
+	return n.Int64(), nil
+}
+
+// ParseBig is like Parse but returns the amount in satang as a *big.Int, so it
+// accepts amounts of any size.
+func ParseBig(text string) (*big.Int, error) {
+	p := &wordParser{input: text}
+	if err := p.split(); err != nil {
+		return nil, err
+	}
+	if len(p.words) == 0 {
+		return nil, &ParseError{Input: text, Offset: 0, Err: ErrEmpty}
+	}
+	return p.amount()
+}
+
+// wordParser turns Thai text into words and then into an amount.
+type wordParser struct {
+	input string
+	words []thaiWord
+	pos   int
+}
+
+// split breaks the input into words using the longest match at each offset.
+func (p *wordParser) split() error {
+	for i := 0; i < len(p.input); {
+		r, size := utf8.DecodeRuneInString(p.input[i:])
+		if unicode.IsSpace(r) {
+			i += size
+			continue
+		}
+
+		var match thaiWord
+		for _, w := range parseVocabulary {
+			if len(w.text) > len(match.text) && strings.HasPrefix(p.input[i:], w.text) {
+				match = w
+			}
+		}
+		if match.text == "" {
+			return p.errorAt(i, "unknown word")
+		}
+
+		match.offset = i
+		p.words = append(p.words, match)
+		i += len(match.text)
+	}
+	return nil
+}
+
+// amount reads [ลบ] number (บาท (ถ้วน | number สตางค์)? | สตางค์).
+func (p *wordParser) amount() (*big.Int, error) {
+	negative := p.accept(wordMinus)
+
+	whole, err := p.number()
+	if err != nil {
+		return nil, err
+	}
+
+	var satang *big.Int
+	switch {
+	case p.accept(wordSatang):
+		satang, whole = whole, new(big.Int)
+	case p.accept(wordBaht):
+		satang = new(big.Int)
+		if p.pos < len(p.words) && !p.accept(wordExact) {
+			if satang, err = p.number(); err != nil {
+				return nil, err
+			}
+			if !p.accept(wordSatang) {
+				return nil, p.errorAt(p.offset(), "expected สตางค์")
+			}
+		}
+	default:
+		return nil, p.errorAt(p.offset(), "expected บาท or สตางค์")
+	}
+
+	if p.pos < len(p.words) {
+		return nil, p.errorAt(p.offset(), "unexpected word after the amount")
+	}
+	if satang.Cmp(bigHundred) >= 0 {
+		return nil, p.errorAt(p.offset(), "satang must be less than one hundred")
+	}
+
+	amount := whole.Mul(whole, bigHundred)
+	amount.Add(amount, satang)
+	if negative {
+		amount.Neg(amount)
+	}
+	return amount, nil
+}
+
+// number reads a whole number written with digit, place and ล้าน words. Places
+// must descend within each six-digit group; a place without a digit, as in
+// "สิบ" or "ล้าน", counts as one of that place.
+func (p *wordParser) number() (*big.Int, error) {
+	total := new(big.Int)
+	million := big.NewInt(1_000_000)
+
+	var group uint64
+	pending := -1  // digit waiting for its place, or -1
+	lastPlace := 6 // place of the last word in this group
+	yi := false    // pending is ยี่, which must be followed by สิบ
+	start := p.pos
+
+	// units adds the pending digit to the group as its last digit.
+	units := func(offset int) error {
+		if pending < 0 {
+			return nil
+		}
+		if yi {
+			return p.errorAt(offset, "expected สิบ after ยี่")
+		}
+		if lastPlace == 0 {
+			return p.errorAt(offset, "unexpected digit after เอ็ด")
+		}
+		group += uint64(pending)
+		pending, lastPlace = -1, 0
+		return nil
+	}
+
+loop:
+	for ; p.pos < len(p.words); p.pos++ {
+		w := p.words[p.pos]
+		switch w.kind {
+		case wordDigit:
+			if w.value == 0 {
+				if p.pos != start || (p.pos+1 < len(p.words) && isNumberWord(p.words[p.pos+1])) {
+					return nil, p.errorAt(w.offset, "ศูนย์ must stand alone")
+				}
+				p.pos++
+				return total, nil
+			}
+			if pending >= 0 {
+				return nil, p.errorAt(w.offset, "unexpected digit")
+			}
+			pending = w.value
+		case wordYi:
+			if pending >= 0 {
+				return nil, p.errorAt(w.offset, "unexpected ยี่")
+			}
+			pending, yi = w.value, true
+		case wordEt:
+			if pending >= 0 || lastPlace == 0 || (group == 0 && total.Sign() == 0) {
+				return nil, p.errorAt(w.offset, "unexpected เอ็ด")
+			}
+			group++
+			lastPlace = 0
+		case wordPlace:
+			if w.value >= lastPlace || (yi && w.value != 1) {
+				return nil, p.errorAt(w.offset, "unexpected "+w.text)
+			}
+			digit := uint64(1)
+			if pending >= 0 {
+				digit = uint64(pending)
+			}
+			group += digit * pow10[w.value]
+			pending, lastPlace, yi = -1, w.value, false
+		case wordMillion:
+			if err := units(w.offset); err != nil {
+				return nil, err
+			}
+			if group == 0 && total.Sign() == 0 {
+				group = 1
+			}
+			total.Add(total, new(big.Int).SetUint64(group))
+			total.Mul(total, million)
+			group, lastPlace = 0, 6
+		default:
+			break loop
+		}
+	}
+
+	if p.pos == start {
+		return nil, p.errorAt(p.offset(), "expected a number")
+	}
+	if err := units(p.offset()); err != nil {
+		return nil, err
+	}
+	return total.Add(total, new(big.Int).SetUint64(group)), nil
+}
+
+// isNumberWord reports whether w can be part of a number.
+func isNumberWord(w thaiWord) bool {
+	return w.kind <= wordMillion
+}
+
+// accept consumes the next word if it is of the given kind.
+func (p *wordParser) accept(kind wordKind) bool {
+	if p.pos < len(p.words) && p.words[p.pos].kind == kind {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// offset returns the offset of the next word, or the end of the input.
+func (p *wordParser) offset() int {
+	if p.pos < len(p.words) {
+		return p.words[p.pos].offset
+	}
+	return len(p.input)
+}
+
+func (p *wordParser) errorAt(offset int, detail string) error {
+	return &ParseError{Input: p.input, Offset: offset, Err: fmt.Errorf("%w: %s", ErrSyntax, detail)}
+}
@@ -0,0 +1,122 @@
+package bahttext
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int64
+	}{
+		{"zero", "ศูนย์บาทถ้วน", 0},
+		{"one", "หนึ่งบาทถ้วน", 100},
+		{"ten", "สิบบาทถ้วน", 1000},
+		{"eleven", "สิบเอ็ดบาทถ้วน", 1100},
+		{"twenty-one", "ยี่สิบเอ็ดบาทถ้วน", 2100},
+		{"one-hundred-one", "หนึ่งร้อยเอ็ดบาทถ้วน", 10100},
+		{"one-thousand-ten", "หนึ่งพันสิบบาทถ้วน", 101000},
+		{"decimal", "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์", 123456},
+		{"satang-only-baht-zero", "ศูนย์บาทยี่สิบห้าสตางค์", 25},
+		{"satang-only", "ห้าสิบสตางค์", 50},
+		{"one-satang", "หนึ่งบาทหนึ่งสตางค์", 101},
+		{"ten-million-one", "สิบล้านเอ็ดบาทถ้วน", 1_000_000_100},
+		{"one-trillion", "หนึ่งล้านล้านบาทถ้วน", 100_000_000_000_000},
+		{"negative", "ลบหนึ่งร้อยบาทห้าสิบสตางค์", -10050},
+		{"negative-zero", "ลบศูนย์บาทถ้วน", 0},
+		{"without-exact-suffix", "หนึ่งร้อยบาท", 10000},
+		{"spaces-between-words", " หนึ่ง พัน สองร้อย บาท ถ้วน ", 120000},
+		{"royal-institute-one", "หนึ่งร้อยหนึ่งบาทถ้วน", 10100},
+		{"omitted-leading-one", "ร้อยบาทถ้วน", 10000},
+		{"omitted-leading-million", "ล้านบาทถ้วน", 100_000_000},
+		{"min-int64", "ลบเก้าหมื่นสองพันสองร้อยสามสิบสามล้านเจ็ดแสนสองหมื่นสามร้อยหกสิบแปดล้านห้าแสนสี่หมื่นเจ็ดพันเจ็ดร้อยห้าสิบแปดบาทแปดสตางค์", math.MinInt64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.want {
+				t.Errorf("Parse(%q) = %d, want %d", tt.input, result, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantErr    error
+		wantOffset int
+	}{
+		{"empty", "", ErrEmpty, 0},
+		{"spaces", "  ", ErrEmpty, 0},
+		{"unknown-word", "หนึ่งดอลลาร์", ErrSyntax, len("หนึ่ง")},
+		{"missing-unit", "หนึ่งร้อย", ErrSyntax, len("หนึ่งร้อย")},
+		{"missing-number", "บาทถ้วน", ErrSyntax, 0},
+		{"two-digits", "หนึ่งสองบาทถ้วน", ErrSyntax, len("หนึ่ง")},
+		{"places-out-of-order", "สองร้อยสามพันบาทถ้วน", ErrSyntax, len("สองร้อยสาม")},
+		{"et-alone", "เอ็ดบาทถ้วน", ErrSyntax, 0},
+		{"yi-without-ten", "ยี่ร้อยบาทถ้วน", ErrSyntax, len("ยี่")},
+		{"zero-not-alone", "ศูนย์หนึ่งบาทถ้วน", ErrSyntax, 0},
+		{"too-many-satang", "หนึ่งบาทหนึ่งร้อยสตางค์", ErrSyntax, len("หนึ่งบาทหนึ่งร้อยสตางค์")},
+		{"missing-satang-word", "หนึ่งบาทห้าสิบ", ErrSyntax, len("หนึ่งบาทห้าสิบ")},
+		{"trailing-words", "หนึ่งบาทถ้วนถ้วน", ErrSyntax, len("หนึ่งบาทถ้วน")},
+		{"out-of-range", "หนึ่งแสนล้านล้านบาทถ้วน", ErrRange, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			}
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("Parse(%q) error = %T, want *ParseError", tt.input, err)
+			}
+			if perr.Offset != tt.wantOffset {
+				t.Errorf("Parse(%q) offset = %d, want %d", tt.input, perr.Offset, tt.wantOffset)
+			}
+		})
+	}
+}
+
+func TestParseBig(t *testing.T) {
+	input := "หนึ่งล้านล้านล้านล้านบาทหนึ่งสตางค์"
+	want, _ := new(big.Int).SetString("100000000000000000000000001", 10)
+
+	result, err := ParseBig(input)
+	if err != nil {
+		t.Fatalf("ParseBig(%q) unexpected error: %v", input, err)
+	}
+	if result.Cmp(want) != 0 {
+		t.Errorf("ParseBig(%q) = %s, want %s", input, result, want)
+	}
+}
+
+func TestParseRoundTrip(t *testing.T) {
+	inputs := []int64{math.MaxInt64, math.MinInt64, 1_000_001_000_000_00, 100_000_000_100}
+	for s := int64(-30_000); s <= 30_000; s += 3 {
+		inputs = append(inputs, s, s*1_000_003)
+	}
+
+	for _, satang := range inputs {
+		text := WordsSatang(satang)
+		result, err := Parse(text)
+		if err != nil {
+			t.Errorf("Parse(%q) unexpected error: %v", text, err)
+			continue
+		}
+		if result != satang {
+			t.Errorf("Parse(WordsSatang(%d)) = %d", satang, result)
+		}
+	}
+}
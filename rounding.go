@@ -0,0 +1,170 @@
+package bahttext
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RoundingMode selects how amounts with fractions finer than a satang are
+// rounded to two decimal places.
+type RoundingMode int
+
+const (
+	// RoundHalfAwayFromZero rounds to the nearest satang, and halves away from
+	// zero: 1.005 -> 1.01, -1.005 -> -1.01. It is not the rounding of Words,
+	// which rounds the float64 value itself and so reads 1.005 as 1.00; the
+	// two agree wherever the float64 is not within a rounding error of a half.
+	RoundHalfAwayFromZero RoundingMode = iota
+	// RoundHalfEven rounds to the nearest satang, and halves to the even
+	// satang (banker's rounding): 1.005 -> 1.00, 1.015 -> 1.02.
+	RoundHalfEven
+	// RoundTruncate drops the extra digits, rounding toward zero:
+	// 1.009 -> 1.00, -1.009 -> -1.00.
+	RoundTruncate
+	// RoundCeiling rounds toward positive infinity: 1.001 -> 1.01,
+	// -1.009 -> -1.00.
+	RoundCeiling
+	// RoundFloor rounds toward negative infinity: 1.009 -> 1.00,
+	// -1.001 -> -1.01.
+	RoundFloor
+	// RoundExact does not round at all; amounts that need rounding are
+	// rejected with ErrTooManyDecimals.
+	RoundExact
+)
+
+var roundingModeNames = []string{"HalfAwayFromZero", "HalfEven", "Truncate", "Ceiling", "Floor", "Exact"}
+
+func (m RoundingMode) String() string {
+	if m < 0 || int(m) >= len(roundingModeNames) {
+		return "RoundingMode(" + strconv.Itoa(int(m)) + ")"
+	}
+	return roundingModeNames[m]
+}
+
+func (m RoundingMode) valid() error {
+	if m < 0 || int(m) >= len(roundingModeNames) {
+		return fmt.Errorf("invalid rounding mode %d", int(m))
+	}
+	return nil
+}
+
+// WordsRounded is like WordsE but rounds the amount with the given mode.
+// Rounding works on the shortest decimal representation of money, so
+// WordsRounded(1.005, RoundHalfAwayFromZero) reads 1.01 even though the nearest
+// float64 is slightly below 1.005. Words keeps rounding the float64 value
+// itself, which gives 1.00 in that case.
+//
+// Example usage:
+//
+//	text, err := baht.WordsRounded(1.005, baht.RoundHalfEven)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(text) // Output: หนึ่งบาทถ้วน
+func WordsRounded(money float64, mode RoundingMode) (string, error) {
+	c, err := New(WithRounding(mode))
+	if err != nil {
+		return "", err
+	}
+	return c.WordsE(money)
+}
+
+// WordsFromStringRounded is like WordsFromString but rounds the amount with the
+// given mode. Plain decimal input is rounded exactly as written, so "1.225"
+// is a true half; other input accepted by WordsFromString, such as "1.2e-1",
+// is rounded like WordsRounded. With RoundExact, a *ParseError wrapping
+// ErrTooManyDecimals points at the first digit beyond the satang.
+func WordsFromStringRounded(money string, mode RoundingMode) (string, error) {
+	c, err := New(WithRounding(mode))
+	if err != nil {
+		return "", err
+	}
+	return c.WordsFromString(money)
+}
+
+// floatDecimal returns the shortest decimal representation of money.
+func floatDecimal(money float64) decimal {
+	s := strconv.FormatFloat(money, 'f', -1, 64)
+	d := decimal{negative: strings.HasPrefix(s, "-")}
+	d.integer, d.fraction, _ = strings.Cut(strings.TrimPrefix(s, "-"), ".")
+	return d
+}
+
+// round returns d rounded to the given number of fraction digits with mode.
+// A negative amount that rounds to zero stays negative, as it does in Words.
+func (d decimal) round(digits int, mode RoundingMode) (decimal, error) {
+	kept := (d.fraction + strings.Repeat("0", digits))[:digits]
+	var dropped string
+	if len(d.fraction) > digits {
+		dropped = strings.TrimRight(d.fraction[digits:], "0")
+	}
+	rounded := decimal{negative: d.negative && !d.isZero(), integer: d.integer, fraction: kept}
+	if dropped == "" {
+		return rounded, nil
+	}
+
+	half := strings.Compare(dropped, "5")
+	all := rounded.integer + rounded.fraction
+	odd := all != "" && (all[len(all)-1]-'0')%2 == 1
+	up, err := mode.roundUp(d.negative, half, odd)
+	if err != nil {
+		return decimal{}, err
+	}
+
+	if up {
+		all = increment(all)
+		rounded.integer, rounded.fraction = all[:len(all)-digits], all[len(all)-digits:]
+	}
+	return rounded, nil
+}
+
+// roundUp reports whether a magnitude with a non-zero dropped part must be
+// rounded up. half compares the dropped part with one half of the last kept
+// digit, and odd reports whether that digit is odd.
+func (m RoundingMode) roundUp(negative bool, half int, odd bool) (bool, error) {
+	switch m {
+	case RoundHalfAwayFromZero, roundFloat:
+		return half >= 0, nil
+	case RoundHalfEven:
+		return half > 0 || (half == 0 && odd), nil
+	case RoundTruncate:
+		return false, nil
+	case RoundCeiling:
+		return !negative, nil
+	case RoundFloor:
+		return negative, nil
+	}
+	return false, ErrTooManyDecimals
+}
+
+// increment adds one to a string of decimal digits.
+func increment(digits string) string {
+	b := []byte(digits)
+	for i := len(b) - 1; i >= 0; i-- {
+		if b[i] < '9' {
+			b[i]++
+			return string(b)
+		}
+		b[i] = '0'
+	}
+	return "1" + string(b)
+}
+
+// fractionDigitOffset returns the offset in s of the fraction digit with the
+// given index, skipping commas, or len(s) if there is none.
+func fractionDigitOffset(s string, index int) int {
+	point := strings.IndexByte(s, '.')
+	if point < 0 {
+		return len(s)
+	}
+	for i := point + 1; i < len(s); i++ {
+		if isDigit(s[i]) {
+			if index == 0 {
+				return i
+			}
+			index--
+		}
+	}
+	return len(s)
+}
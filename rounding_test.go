@@ -0,0 +1,151 @@
+package bahttext
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestRoundingModes(t *testing.T) {
+	const (
+		oneBaht         = "หนึ่งบาทถ้วน"
+		oneBahtOne      = "หนึ่งบาทหนึ่งสตางค์"
+		oneBahtTwo      = "หนึ่งบาทสองสตางค์"
+		twoBaht         = "สองบาทถ้วน"
+		oneBahtNinety9  = "หนึ่งบาทเก้าสิบเก้าสตางค์"
+		oneBahtTwenty2  = "หนึ่งบาทยี่สิบสองสตางค์"
+		oneBahtTwenty3  = "หนึ่งบาทยี่สิบสามสตางค์"
+		minusOneBaht    = "ลบ" + oneBaht
+		minusOneBahtOne = "ลบ" + oneBahtOne
+		minusOneBahtTwo = "ลบ" + oneBahtTwo
+	)
+
+	tests := []struct {
+		input string
+		mode  RoundingMode
+		want  string
+	}{
+		{"1.005", RoundHalfAwayFromZero, oneBahtOne},
+		{"1.005", RoundHalfEven, oneBaht},
+		{"1.005", RoundTruncate, oneBaht},
+		{"1.005", RoundCeiling, oneBahtOne},
+		{"1.005", RoundFloor, oneBaht},
+
+		{"1.015", RoundHalfAwayFromZero, oneBahtTwo},
+		{"1.015", RoundHalfEven, oneBahtTwo},
+		{"1.015", RoundTruncate, oneBahtOne},
+		{"1.015", RoundCeiling, oneBahtTwo},
+		{"1.015", RoundFloor, oneBahtOne},
+
+		{"1.225", RoundHalfAwayFromZero, oneBahtTwenty3},
+		{"1.225", RoundHalfEven, oneBahtTwenty2},
+		{"1.2250001", RoundHalfEven, oneBahtTwenty3},
+		{"1.2249999", RoundHalfAwayFromZero, oneBahtTwenty2},
+
+		{"-1.005", RoundHalfAwayFromZero, minusOneBahtOne},
+		{"-1.005", RoundHalfEven, minusOneBaht},
+		{"-1.005", RoundTruncate, minusOneBaht},
+		{"-1.005", RoundCeiling, minusOneBaht},
+		{"-1.005", RoundFloor, minusOneBahtOne},
+
+		{"-1.015", RoundHalfAwayFromZero, minusOneBahtTwo},
+		{"-1.015", RoundHalfEven, minusOneBahtTwo},
+		{"-1.015", RoundTruncate, minusOneBahtOne},
+		{"-1.015", RoundCeiling, minusOneBahtOne},
+		{"-1.015", RoundFloor, minusOneBahtTwo},
+
+		{"1.995", RoundHalfAwayFromZero, twoBaht},
+		{"1.995", RoundHalfEven, twoBaht},
+		{"1.995", RoundTruncate, oneBahtNinety9},
+		{"1.995", RoundCeiling, twoBaht},
+		{"1.995", RoundFloor, oneBahtNinety9},
+
+		{"1.01", RoundExact, oneBahtOne},
+		{"1.0100", RoundExact, oneBahtOne},
+		{"0.29", RoundTruncate, "ศูนย์บาทยี่สิบเก้าสตางค์"},
+		{"-0.001", RoundHalfAwayFromZero, "ลบศูนย์บาทถ้วน"},
+		{"99.999", RoundCeiling, "หนึ่งร้อยบาทถ้วน"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String()+"/"+tt.input, func(t *testing.T) {
+			result, err := WordsFromStringRounded(tt.input, tt.mode)
+			if err != nil {
+				t.Fatalf("WordsFromStringRounded(%q, %v) unexpected error: %v", tt.input, tt.mode, err)
+			}
+			if result != tt.want {
+				t.Errorf("WordsFromStringRounded(%q, %v) = %s, want %s", tt.input, tt.mode, result, tt.want)
+			}
+
+			money, _ := strconv.ParseFloat(tt.input, 64)
+			result, err = WordsRounded(money, tt.mode)
+			if err != nil {
+				t.Fatalf("WordsRounded(%v, %v) unexpected error: %v", money, tt.mode, err)
+			}
+			if result != tt.want {
+				t.Errorf("WordsRounded(%v, %v) = %s, want %s", money, tt.mode, result, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoundHalfAwayFromZeroVersusWords(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       float64
+		wantWords   string
+		wantRounded string
+	}{
+		{"clear-half", -51.995, "ลบห้าสิบสองบาทถ้วน", "ลบห้าสิบสองบาทถ้วน"},
+		{"below-half", -51.994, "ลบห้าสิบเอ็ดบาทเก้าสิบเก้าสตางค์", "ลบห้าสิบเอ็ดบาทเก้าสิบเก้าสตางค์"},
+		{"float-above-half", 2.675, "สองบาทหกสิบแปดสตางค์", "สองบาทหกสิบแปดสตางค์"},
+
+		// The float64 nearest to these is just below the half, which Words
+		// rounds down and RoundHalfAwayFromZero, reading it as written, up.
+		{"float-below-half", 1.005, "หนึ่งบาทถ้วน", "หนึ่งบาทหนึ่งสตางค์"},
+		{"float-below-half-odd", 1.015, "หนึ่งบาทหนึ่งสตางค์", "หนึ่งบาทสองสตางค์"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Words(tt.input); got != tt.wantWords {
+				t.Errorf("Words(%v) = %s, want %s", tt.input, got, tt.wantWords)
+			}
+			got, err := WordsRounded(tt.input, RoundHalfAwayFromZero)
+			if err != nil {
+				t.Fatalf("WordsRounded(%v) unexpected error: %v", tt.input, err)
+			}
+			if got != tt.wantRounded {
+				t.Errorf("WordsRounded(%v) = %s, want %s", tt.input, got, tt.wantRounded)
+			}
+		})
+	}
+}
+
+func TestRoundExactErrors(t *testing.T) {
+	_, err := WordsRounded(1.005, RoundExact)
+	if !errors.Is(err, ErrTooManyDecimals) {
+		t.Errorf("WordsRounded(1.005, RoundExact) error = %v, want ErrTooManyDecimals", err)
+	}
+
+	_, err = WordsFromStringRounded(" 1,234.5678", RoundExact)
+	var perr *ParseError
+	if !errors.As(err, &perr) || !errors.Is(err, ErrTooManyDecimals) {
+		t.Fatalf("WordsFromStringRounded error = %v, want a *ParseError wrapping ErrTooManyDecimals", err)
+	}
+	if perr.Offset != 9 {
+		t.Errorf("ParseError.Offset = %d, want 9", perr.Offset)
+	}
+}
+
+func TestRoundingInvalidInput(t *testing.T) {
+	if _, err := WordsRounded(1, RoundingMode(42)); err == nil {
+		t.Errorf("WordsRounded with an invalid mode expected error, got nil")
+	}
+	if _, err := WordsFromStringRounded("abc", RoundHalfEven); !errors.Is(err, ErrSyntax) {
+		t.Errorf("WordsFromStringRounded(%q) error = %v, want ErrSyntax", "abc", err)
+	}
+	if result, err := WordsFromStringRounded("1.2345e2", RoundTruncate); err != nil || result != "หนึ่งร้อยยี่สิบสามบาทสี่สิบห้าสตางค์" {
+		t.Errorf("WordsFromStringRounded(%q) = %s, %v", "1.2345e2", result, err)
+	}
+}
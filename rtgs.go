@@ -0,0 +1,78 @@
+package bahttext
+
+import "fmt"
+
+// rtgs is the Thai reading romanized with the Royal Thai General System of
+// Transcription. บาท is written "baht", the spelling banks use, rather than
+// the strict transcription "bat".
+var rtgs = thaiScript{
+	digits: []string{"", "nueng", "song", "sam", "si", "ha", "hok", "chet", "paet", "kao"},
+	places: []string{"", "sip", "roi", "phan", "muen", "saen", "lan"},
+	twenty: []string{"yi", "sip"},
+	et:     "et",
+	units: Units{
+		Sign:       "lop",
+		Major:      "baht",
+		Minor:      "satang",
+		Exact:      "thuan",
+		Zero:       "sun",
+		Point:      "chut",
+		Annotation: "(khat thun)",
+	},
+}
+
+// rtgsPrefixes romanizes the common prefixes of Ordinal.
+var rtgsPrefixes = map[string][]string{
+	PrefixOrdinal:     {"thi"},
+	PrefixTime:        {"khrang", "thi"},
+	PrefixInstallment: {"nguat", "thi"},
+	PrefixClause:      {"kho"},
+}
+
+// rtgsSpeller is the Thai Speller with the RTGS words, separator and letter
+// case.
+type rtgsSpeller struct {
+	thaiSpeller
+}
+
+func (s rtgsSpeller) Units() Units {
+	units := s.script.units
+	units.Separator = s.c.rtgs.separator
+	return units
+}
+
+func (s rtgsSpeller) capitalization() Capitalization {
+	return s.c.rtgs.capitalization
+}
+
+// rtgsFormat holds the RTGS options of a Converter.
+type rtgsFormat struct {
+	separator      string // between words
+	capitalization Capitalization
+}
+
+// RTGSSeparator sets the text between the words of RTGS readings. It must be
+// a single space, a hyphen or empty; the default is a space, and a hyphen
+// reads 1200 as "nueng-phan-song-roi-baht-thuan".
+func RTGSSeparator(separator string) Option {
+	return func(c *Converter) error {
+		if separator != " " && separator != "-" && separator != "" {
+			return fmt.Errorf("invalid RTGS separator %q", separator)
+		}
+		c.rtgs.separator = separator
+		return nil
+	}
+}
+
+// RTGSCapitalization sets the letter case of RTGS readings of amounts. It is
+// CapitalizeLower by default. CapitalizeTitle capitalizes each part of a
+// hyphen separated reading.
+func RTGSCapitalization(capitalization Capitalization) Option {
+	return func(c *Converter) error {
+		if err := capitalization.valid(); err != nil {
+			return err
+		}
+		c.rtgs.capitalization = capitalization
+		return nil
+	}
+}
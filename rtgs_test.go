@@ -0,0 +1,129 @@
+package bahttext
+
+import (
+	"testing"
+	"unicode"
+)
+
+func TestRTGSWords(t *testing.T) {
+	c, err := New(WithLanguage(RTGS))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		input float64
+		want  string
+	}{
+		{"zero", 0, "sun baht thuan"},
+		{"digits", 123456789, "nueng roi yi sip sam lan si saen ha muen hok phan chet roi paet sip kao baht thuan"},
+		{"thousands", 1200, "nueng phan song roi baht thuan"},
+		{"satang", 1234.56, "nueng phan song roi sam sip si baht ha sip hok satang"},
+		{"yi-sip-et", 21, "yi sip et baht thuan"},
+		{"sip-et", 11, "sip et baht thuan"},
+		{"roi-et", 101, "nueng roi et baht thuan"},
+		{"lan", 1_001_001, "nueng lan nueng phan et baht thuan"},
+		{"negative", -5.5, "lop ha baht ha sip satang"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.Words(tt.input); got != tt.want {
+				t.Errorf("RTGS Words(%v) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRTGSOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  []Option
+		input float64
+		want  string
+	}{
+		{"hyphen", []Option{RTGSSeparator("-")}, 1200, "nueng-phan-song-roi-baht-thuan"},
+		{"no-separator", []Option{RTGSSeparator("")}, 21, "yisipetbahtthuan"},
+		{"sentence", []Option{RTGSCapitalization(CapitalizeSentence)}, 1200, "Nueng phan song roi baht thuan"},
+		{"title-hyphen", []Option{RTGSSeparator("-"), RTGSCapitalization(CapitalizeTitle)}, 20.25, "Yi-Sip-Baht-Yi-Sip-Ha-Satang"},
+		{"upper", []Option{RTGSCapitalization(CapitalizeUpper)}, 1, "NUENG BAHT THUAN"},
+		{"royal", []Option{WithReadingStyle(RoyalInstitute)}, 101, "nueng roi nueng baht thuan"},
+		{"omit-leading-one", []Option{OmitLeadingOne()}, 1_000_000, "lan baht thuan"},
+		{"parentheses", []Option{WithNegativeStyle(NegativeParentheses, NegativeZeroSigned)}, -1, "(nueng baht thuan)"},
+		{"annotation", []Option{WithNegativeStyle(NegativeAnnotation, NegativeZeroSigned)}, -1, "nueng baht thuan (khat thun)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(append([]Option{WithLanguage(RTGS)}, tt.opts...)...)
+			if err != nil {
+				t.Fatalf("New() unexpected error: %v", err)
+			}
+			if got := c.Words(tt.input); got != tt.want {
+				t.Errorf("RTGS Words(%v) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRTGSIsASCII(t *testing.T) {
+	c, err := New(WithLanguage(RTGS))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	for satang := int64(-100_000); satang <= 100_000; satang += 37 {
+		text := must(c.WordsSatang(satang * 1001))
+		for _, r := range text {
+			if r > unicode.MaxASCII {
+				t.Fatalf("RTGS WordsSatang(%d) = %s, contains non-ASCII %q", satang*1001, text, r)
+			}
+		}
+	}
+}
+
+func TestLanguageNumberAndDecimal(t *testing.T) {
+	tests := []struct {
+		lang    Language
+		number  string
+		decimal string
+	}{
+		{Thai, "ลบหนึ่งร้อยยี่สิบเอ็ด", "สามจุดศูนย์ห้า"},
+		{English, "minus one hundred twenty-one", "three point zero five"},
+		{RTGS, "lop nueng roi yi sip et", "sam chut sun ha"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang.String(), func(t *testing.T) {
+			c, err := New(WithLanguage(tt.lang))
+			if err != nil {
+				t.Fatalf("New() unexpected error: %v", err)
+			}
+			if got := c.Number(-121); got != tt.number {
+				t.Errorf("Number(-121) = %s, want %s", got, tt.number)
+			}
+			got, err := c.Decimal("3.05")
+			if err != nil {
+				t.Fatalf("Decimal() unexpected error: %v", err)
+			}
+			if got != tt.decimal {
+				t.Errorf("Decimal(3.05) = %s, want %s", got, tt.decimal)
+			}
+		})
+	}
+}
+
+func TestRTGSInvalid(t *testing.T) {
+	for _, sep := range []string{"_", "  ", " - ", "\t"} {
+		if _, err := New(RTGSSeparator(sep)); err == nil {
+			t.Errorf("New(RTGSSeparator(%q)) expected error, got nil", sep)
+		}
+	}
+	if _, err := New(RTGSCapitalization(Capitalization(-1))); err == nil {
+		t.Errorf("New(RTGSCapitalization(-1)) expected error, got nil")
+	}
+	if got, want := Language(9).String(), "Language(9)"; got != want {
+		t.Errorf("Language(9).String() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,302 @@
+package bahttext
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Speller spells numbers for one locale. The Converter does everything else:
+// it rounds the amount, splits it into whole units and satang, splits the
+// whole units into groups of digits, applies the NegativeStyle and assembles
+// the amount from the words in Units. The built-in languages are Spellers
+// too, selected with WithLanguage; others are plugged in with WithSpeller or
+// registered for WithLocale.
+//
+// A number is spelled group by group from the most significant one. Each
+// group that is not all zeros goes to SpellGroup, and once a group has been
+// spelled, every following boundary between groups goes to JoinScale. For
+// 1,234,567 read in groups of three, SpellGroup gets "001" at index 2, then
+// JoinScale gets index 2, SpellGroup "234" at index 1, JoinScale index 1 and
+// finally SpellGroup "567" at index 0.
+type Speller interface {
+	// GroupSize returns the number of digits in a group: 6 for Thai,
+	// whose ล้าน recurs every six digits, 3 for English and 4 for Chinese.
+	GroupSize() int
+
+	// SpellGroup appends the words for group to words, which holds the
+	// words of the higher groups. group has GroupSize decimal digits,
+	// possibly with leading zeros, and is not all zeros. index counts the
+	// groups from the right, starting at 0. skipped reports that one or
+	// more all-zero groups came between the previous group spelled and this
+	// one.
+	SpellGroup(words []string, group string, index int, skipped bool) []string
+
+	// JoinScale appends the scale word that follows the group at index,
+	// which is at least 1, to words. zero reports that the group was all
+	// zeros, which some locales skip and others, like Thai, still name.
+	JoinScale(words []string, index int, zero bool) []string
+
+	// SpellDigit returns the word for a digit from 1 to 9, as read after
+	// the decimal point by Decimal.
+	SpellDigit(digit int) string
+
+	// Units returns the words amounts are assembled from.
+	Units() Units
+}
+
+// Units holds the words a Converter assembles readings from. WithSpeller and
+// WithLanguage copy them into the Converter, where the options such as
+// WithSign and WithMajorUnit can change them. The Converter writes
+//
+//	[Sign] number Major Exact              for whole amounts
+//	[Sign] number Major number Minor       otherwise
+//
+// with Separator between the words.
+type Units struct {
+	Sign       string // word before negative amounts, such as "ลบ"
+	Major      string // word after the whole units, such as "บาท"
+	Minor      string // word after the satang, such as "สตางค์"
+	Exact      string // word ending whole amounts, such as "ถ้วน"; may be empty
+	Zero       string // word for zero, such as "ศูนย์"
+	Point      string // word for the decimal point, such as "จุด"
+	Annotation string // written after negative amounts by NegativeAnnotation
+	Separator  string // written between words: empty for Thai, a space for English
+}
+
+// amountSpeller is implemented by the built-in Spellers that assemble amounts
+// differently from Units, such as English with its "and".
+type amountSpeller interface {
+	spellAmount(noBaht bool, baht string, satang uint64) []Token
+}
+
+// capitalizer is implemented by the built-in Spellers with a letter case
+// option.
+type capitalizer interface {
+	capitalization() Capitalization
+}
+
+// groupSpeller is implemented by the built-in Spellers that tell the kind of
+// each word of a group, for Tokens. groupTokens spells group like SpellGroup,
+// with started reporting that a higher group has been spelled, and
+// scaleTokens joins the scale like JoinScale. Positions count from the start
+// of the group at index 0.
+type groupSpeller interface {
+	groupTokens(group string, index int, started, skipped bool) []Token
+	scaleTokens(index int, zero bool) []Token
+}
+
+var (
+	spellersMu sync.RWMutex
+	spellers   = map[string]Speller{}
+)
+
+// locales names the built-in languages for WithLocale.
+var locales = map[string]Language{
+	"th":      Thai,
+	"en":      English,
+	"th-Latn": RTGS,
+	"zh":      Chinese,
+	"lo":      Lao,
+}
+
+// RegisterSpeller makes s available to WithLocale under the given locale
+// name, such as "vi" or "km-KH". It is meant to be called from an init
+// function. It returns an error if the name is empty or already taken,
+// including by a built-in language, or if s is rejected by WithSpeller.
+func RegisterSpeller(locale string, s Speller) error {
+	if locale == "" {
+		return fmt.Errorf("empty locale")
+	}
+	if err := checkSpeller(s); err != nil {
+		return fmt.Errorf("locale %s: %w", locale, err)
+	}
+
+	spellersMu.Lock()
+	defer spellersMu.Unlock()
+	if _, ok := locales[locale]; ok {
+		return fmt.Errorf("locale %s already registered", locale)
+	}
+	if _, ok := spellers[locale]; ok {
+		return fmt.Errorf("locale %s already registered", locale)
+	}
+	spellers[locale] = s
+	return nil
+}
+
+// WithLocale makes the Converter read with the built-in language or the
+// registered Speller of the given locale name. The built-in languages are
+// "th", "en", "th-Latn" for RTGS, "zh" and "lo". It returns an error wrapping
+// ErrUnknownLocale for names that are not registered.
+func WithLocale(locale string) Option {
+	return func(c *Converter) error {
+		if lang, ok := locales[locale]; ok {
+			return WithLanguage(lang)(c)
+		}
+
+		spellersMu.RLock()
+		s, ok := spellers[locale]
+		spellersMu.RUnlock()
+		if !ok {
+			return fmt.Errorf("%w: %q", ErrUnknownLocale, locale)
+		}
+		return WithSpeller(s)(c)
+	}
+}
+
+// WithSpeller makes the Converter spell numbers with s and resets the words
+// to s.Units(), so options changing these words must come after it. It
+// returns an error if s is nil, its GroupSize is not positive, or its Units
+// have no Zero or Major word, or if it comes after WithCurrency.
+func WithSpeller(s Speller) Option {
+	return func(c *Converter) error {
+		if err := checkSpeller(s); err != nil {
+			return err
+		}
+		if c.currency != "" {
+			return fmt.Errorf("speller set after currency %s", c.currency)
+		}
+		c.custom = s
+		c.setUnits(s.Units())
+		return nil
+	}
+}
+
+// checkSpeller rejects Spellers a Converter cannot use.
+func checkSpeller(s Speller) error {
+	if s == nil {
+		return fmt.Errorf("nil speller")
+	}
+	if size := s.GroupSize(); size < 1 {
+		return fmt.Errorf("invalid speller group size %d", size)
+	}
+	if units := s.Units(); units.Zero == "" || units.Major == "" {
+		return fmt.Errorf("speller units need a zero and a major unit word")
+	}
+	return nil
+}
+
+// Speller returns the Speller c reads with, bound to c's options. It lets a
+// custom Speller wrap a built-in language.
+func (c *Converter) Speller() Speller {
+	c = c.ready()
+	return c.speller()
+}
+
+// setUnits copies units into the words of c.
+func (c *Converter) setUnits(units Units) {
+	c.minus, c.baht, c.exact, c.satang = units.Sign, units.Major, units.Exact, units.Minor
+	c.zero, c.point, c.annotation = units.Zero, units.Point, units.Annotation
+}
+
+// speller returns the Speller of c's language, or the one set by WithSpeller.
+func (c *Converter) speller() Speller {
+	if c.custom != nil {
+		return c.custom
+	}
+	switch c.language {
+	case English:
+		return englishSpeller{c}
+	case Chinese:
+		return chineseSpeller{c}
+	case RTGS:
+		return rtgsSpeller{thaiSpeller{c, &rtgs}}
+	case Lao:
+		return thaiSpeller{c, &lao}
+	}
+	if c.vocabulary != nil {
+		return thaiSpeller{c, c.vocabulary}
+	}
+	return thaiSpeller{c, &thai}
+}
+
+// separator returns the text between two words.
+func (c *Converter) separator() string {
+	return c.speller().Units().Separator
+}
+
+// join joins the non-empty words with the separator of c's speller.
+func (c *Converter) join(words ...string) string {
+	kept := make([]string, 0, len(words))
+	for _, word := range words {
+		if word != "" {
+			kept = append(kept, word)
+		}
+	}
+	return strings.Join(kept, c.separator())
+}
+
+// cardinal reads a non-negative integer written as decimal digits of any
+// length, group by group with c's speller.
+func (c *Converter) cardinal(digits string) string {
+	return tokenText(c.separate(c.cardinalTokens(digits, 0)))
+}
+
+// cardinalTokens is like cardinal but returns the words as tokens, without
+// separators. shift is the Position of the last digit, negative for digits
+// below the decimal point. The words of Spellers that are not groupSpellers
+// become TokenWord and TokenScale tokens.
+func (c *Converter) cardinalTokens(digits string, shift int) []Token {
+	digits = strings.TrimLeft(digits, "0")
+	if digits == "" {
+		return []Token{{Text: c.zero, Kind: TokenDigit, Position: shift}}
+	}
+
+	s := c.speller()
+	gs, typed := s.(groupSpeller)
+	size := s.GroupSize()
+	if n := len(digits) % size; n > 0 {
+		digits = strings.Repeat("0", size-n) + digits
+	}
+
+	var (
+		tokens []Token
+		words  []string
+	)
+	// spelled appends the words s added to words as tokens of the given kind
+	spelled := func(more []string, kind TokenKind, position int) {
+		for _, w := range more[len(words):] {
+			tokens = append(tokens, Token{Text: w, Kind: kind, Digit: -1, Position: position})
+		}
+		words = more
+	}
+
+	started, skipped := false, false
+	for i := 0; i < len(digits); i += size {
+		group := digits[i : i+size]
+		index := (len(digits)-i)/size - 1
+		zero := strings.Trim(group, "0") == ""
+
+		if !zero {
+			if typed {
+				tokens = append(tokens, gs.groupTokens(group, index, started, skipped)...)
+			} else {
+				spelled(s.SpellGroup(words, group, index, skipped), TokenWord, index*size)
+			}
+			started, skipped = true, false
+		} else if started {
+			skipped = true
+		}
+		if started && index > 0 {
+			if typed {
+				tokens = append(tokens, gs.scaleTokens(index, zero)...)
+			} else {
+				spelled(s.JoinScale(words, index, zero), TokenScale, index*size)
+			}
+		}
+	}
+
+	for i := range tokens {
+		tokens[i].Position += shift
+	}
+	return tokens
+}
+
+// digitWord returns the word for a single digit, as read after the decimal
+// point.
+func (c *Converter) digitWord(digit int) string {
+	if digit == 0 {
+		return c.zero
+	}
+	return c.speller().SpellDigit(digit)
+}
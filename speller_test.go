@@ -0,0 +1,222 @@
+package bahttext
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+// indonesianSpeller is a minimal third-party Speller used to check that the
+// Converter does the decomposition, rounding and sign handling for it.
+type indonesianSpeller struct{}
+
+var (
+	indonesianOnes   = []string{"", "satu", "dua", "tiga", "empat", "lima", "enam", "tujuh", "delapan", "sembilan"}
+	indonesianScales = []string{"", "ribu", "juta", "miliar", "triliun"}
+)
+
+func (indonesianSpeller) GroupSize() int { return 3 }
+
+func (indonesianSpeller) SpellDigit(digit int) string { return indonesianOnes[digit] }
+
+func (indonesianSpeller) Units() Units {
+	return Units{Sign: "minus", Major: "rupiah", Minor: "sen", Zero: "nol", Point: "koma", Annotation: "(rugi)", Separator: " "}
+}
+
+func (indonesianSpeller) SpellGroup(words []string, group string, index int, skipped bool) []string {
+	n, _ := strconv.Atoi(group)
+	if n == 1 && index == 1 {
+		return append(words, "seribu")
+	}
+
+	switch hundreds := n / 100; {
+	case hundreds == 1:
+		words = append(words, "seratus")
+	case hundreds > 1:
+		words = append(words, indonesianOnes[hundreds], "ratus")
+	}
+
+	switch rest := n % 100; {
+	case rest == 0:
+	case rest == 10:
+		words = append(words, "sepuluh")
+	case rest == 11:
+		words = append(words, "sebelas")
+	case rest < 10:
+		words = append(words, indonesianOnes[rest])
+	case rest < 20:
+		words = append(words, indonesianOnes[rest-10], "belas")
+	default:
+		words = append(words, indonesianOnes[rest/10], "puluh")
+		if rest%10 > 0 {
+			words = append(words, indonesianOnes[rest%10])
+		}
+	}
+	return words
+}
+
+func (indonesianSpeller) JoinScale(words []string, index int, zero bool) []string {
+	if zero || words[len(words)-1] == "seribu" {
+		return words
+	}
+	return append(words, indonesianScales[index])
+}
+
+func TestCustomSpeller(t *testing.T) {
+	c, err := New(WithSpeller(indonesianSpeller{}))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		input float64
+		want  string
+	}{
+		{"zero", 0, "nol rupiah"},
+		{"thousand", 1234, "seribu dua ratus tiga puluh empat rupiah"},
+		{"teens", 15, "lima belas rupiah"},
+		{"million", 2_001_000, "dua juta seribu rupiah"},
+		{"zero-group", 5_000_000_007, "lima miliar tujuh rupiah"},
+		{"sen", 1.5, "satu rupiah lima puluh sen"},
+		{"rounding", 1.005, "satu rupiah"},
+		{"negative", -11.01, "minus sebelas rupiah satu sen"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.Words(tt.input); got != tt.want {
+				t.Errorf("Words(%v) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	got, err := c.Decimal("3.05")
+	if err != nil {
+		t.Fatalf("Decimal() unexpected error: %v", err)
+	}
+	if want := "tiga koma nol lima"; got != want {
+		t.Errorf("Decimal(3.05) = %s, want %s", got, want)
+	}
+}
+
+func TestCustomSpellerOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  []Option
+		input float64
+		want  string
+	}{
+		{"exact-suffix", []Option{WithExactSuffix("saja")}, 100, "seratus rupiah saja"},
+		{"parentheses", []Option{WithNegativeStyle(NegativeParentheses, NegativeZeroSigned)}, -100, "(seratus rupiah)"},
+		{"annotation", []Option{WithNegativeStyle(NegativeAnnotation, NegativeZeroSigned)}, -100, "seratus rupiah (rugi)"},
+		{"rounding-mode", []Option{WithRounding(RoundHalfEven)}, 0.125, "nol rupiah dua belas sen"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(append([]Option{WithSpeller(indonesianSpeller{})}, tt.opts...)...)
+			if err != nil {
+				t.Fatalf("New() unexpected error: %v", err)
+			}
+			if got := c.Words(tt.input); got != tt.want {
+				t.Errorf("Words(%v) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestThaiThroughSpeller(t *testing.T) {
+	// A Converter given the Thai Speller explicitly reads exactly like the
+	// default one.
+	c, err := New(WithSpeller(std.Speller()))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	for _, satang := range []int64{0, 1, 21, 101, 1_000_000_00, 1_000_001_00, 1_000_000_000_000_00, 123_456_789_012_34, -51_99} {
+		if got, want := must(c.WordsSatang(satang)), WordsSatang(satang); got != want {
+			t.Errorf("WordsSatang(%d) = %s, want %s", satang, got, want)
+		}
+	}
+}
+
+func TestRegisterSpeller(t *testing.T) {
+	t.Cleanup(func() {
+		spellersMu.Lock()
+		delete(spellers, "id")
+		spellersMu.Unlock()
+	})
+
+	if err := RegisterSpeller("id", indonesianSpeller{}); err != nil {
+		t.Fatalf("RegisterSpeller() unexpected error: %v", err)
+	}
+	if err := RegisterSpeller("id", indonesianSpeller{}); err == nil {
+		t.Errorf("RegisterSpeller(id) twice expected error, got nil")
+	}
+
+	c, err := New(WithLocale("id"))
+	if err != nil {
+		t.Fatalf("New(WithLocale(id)) unexpected error: %v", err)
+	}
+	if got, want := c.Words(21), "dua puluh satu rupiah"; got != want {
+		t.Errorf("Words(21) = %s, want %s", got, want)
+	}
+
+	// A later language replaces the registered Speller
+	thai, err := New(WithLocale("id"), WithLanguage(Thai))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	if got, want := thai.Words(21), "ยี่สิบเอ็ดบาทถ้วน"; got != want {
+		t.Errorf("Words(21) = %s, want %s", got, want)
+	}
+}
+
+func TestWithLocale(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{"th", "ยี่สิบเอ็ดบาทถ้วน"},
+		{"en", "Twenty-one baht"},
+		{"th-Latn", "yi sip et baht thuan"},
+		{"zh", "泰铢贰拾壹元整"},
+		{"lo", "ຊາວເອັດກີບ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			c, err := New(WithLocale(tt.locale))
+			if err != nil {
+				t.Fatalf("New() unexpected error: %v", err)
+			}
+			if got := c.Words(21); got != tt.want {
+				t.Errorf("Words(21) = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := New(WithLocale("xx")); !errors.Is(err, ErrUnknownLocale) {
+		t.Errorf("New(WithLocale(xx)) error = %v, want ErrUnknownLocale", err)
+	}
+}
+
+// emptySpeller is an invalid Speller with no group size or words.
+type emptySpeller struct{ indonesianSpeller }
+
+func (emptySpeller) GroupSize() int { return 0 }
+
+func TestSpellerInvalid(t *testing.T) {
+	if _, err := New(WithSpeller(nil)); err == nil {
+		t.Errorf("New(WithSpeller(nil)) expected error, got nil")
+	}
+	if _, err := New(WithSpeller(emptySpeller{})); err == nil {
+		t.Errorf("New(WithSpeller(emptySpeller)) expected error, got nil")
+	}
+	for _, locale := range []string{"", "th", "en"} {
+		if err := RegisterSpeller(locale, indonesianSpeller{}); err == nil {
+			t.Errorf("RegisterSpeller(%q) expected error, got nil", locale)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package bahttext
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// decimal is an exact decimal number kept as digit strings, so that it can be
+// converted without passing through float64.
+type decimal struct {
+	negative bool
+	integer  string // digits before the point, without separators
+	fraction string // digits after the point
+}
+
+// isZero reports whether every digit of d is zero.
+func (d decimal) isZero() bool {
+	return strings.Trim(d.integer+d.fraction, "0") == ""
+}
+
+// minorUnits returns the magnitude of d in minor units of the given number of
+// digits, such as satang for two. d must have at most that many fraction
+// digits. ok is false if the magnitude does not fit in a uint64.
+func (d decimal) minorUnits(digits int) (units uint64, ok bool) {
+	for _, c := range d.integer + (d.fraction + strings.Repeat("0", digits))[:digits] {
+		digit := uint64(c - '0')
+		if units > (math.MaxUint64-digit)/10 {
+			return 0, false
+		}
+		units = units*10 + digit
+	}
+	return units, true
+}
+
+// ParseStrict parses a money amount written in the strict grammar and returns it
+// in satang. Surrounding white space is ignored; otherwise the input must be an
+// optional sign, digits with thousands separators either omitted or placed
+// every three digits, and at most two fraction digits:
+//
+//	1234    1,234    -1,234.5    +0.05
+//
+// Inputs such as "1,2,3", ",,5", "01,000", "00", "1e3", "0x1p4", "Infinity",
+// "1_000" and "1.234" are rejected with a *ParseError. Amounts beyond the int64 range of
+// satang are rejected with ErrRange.
+func ParseStrict(money string) (satang int64, err error) {
+	d, err := scanDecimal(money, 2)
+	if err != nil {
+		return 0, err
+	}
+
+	magnitude, ok := d.minorUnits(2)
+	if !ok || magnitude > math.MaxInt64+1 || (!d.negative && magnitude > math.MaxInt64) {
+		return 0, &ParseError{Input: money, Offset: leadingSpace(money), Err: ErrRange}
+	}
+
+	if d.negative {
+		// Negating in uint64 wraps to the two's complement, which also
+		// covers math.MinInt64.
+		return int64(-magnitude), nil
+	}
+	return int64(magnitude), nil
+}
+
+// WordsFromStringStrict is like WordsFromString but accepts only the strict
+// grammar described at ParseStrict. The amount is converted exactly, whatever
+// its size, without passing through float64.
+//
+// Example usage:
+//
+//	text, err := baht.WordsFromStringStrict("1,234.56")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(text) // Output: หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์
+func WordsFromStringStrict(money string) (string, error) {
+	return std.WordsFromStringStrict(money)
+}
+
+// scanDecimal parses s in the strict grammar. A negative maxFraction allows
+// any number of fraction digits.
+func scanDecimal(s string, maxFraction int) (decimal, error) {
+	syntaxError := func(offset int, detail string) error {
+		return &ParseError{Input: s, Offset: offset, Err: fmt.Errorf("%w: %s", ErrSyntax, detail)}
+	}
+
+	var d decimal
+	i := leadingSpace(s)
+	end := len(strings.TrimRightFunc(s, unicode.IsSpace))
+	if i >= end {
+		return d, &ParseError{Input: s, Offset: 0, Err: ErrEmpty}
+	}
+
+	if s[i] == '+' || s[i] == '-' {
+		d.negative = s[i] == '-'
+		i++
+	}
+
+	// Integer part: either plain digits or groups of three after the first
+	// one to three digits.
+	var integer strings.Builder
+	start := i
+	for i < end && isDigit(s[i]) {
+		i++
+	}
+	if i == start {
+		return d, syntaxError(i, "expected a digit")
+	}
+	if s[start] == '0' && (i-start > 1 || i < end && s[i] == ',') {
+		return d, syntaxError(start, "leading zero")
+	}
+	integer.WriteString(s[start:i])
+
+	if i < end && s[i] == ',' {
+		if i-start > 3 {
+			return d, syntaxError(i, "thousands separator after more than three digits")
+		}
+		for i < end && s[i] == ',' {
+			group := i + 1
+			j := group
+			for j < end && isDigit(s[j]) {
+				j++
+			}
+			if j-group != 3 {
+				return d, syntaxError(min(group+3, j), "thousands separator must be followed by exactly three digits")
+			}
+			integer.WriteString(s[group:j])
+			i = j
+		}
+	}
+	d.integer = integer.String()
+
+	if i < end && s[i] == '.' {
+		i++
+		start = i
+		for i < end && isDigit(s[i]) {
+			i++
+		}
+		if i == start {
+			return d, syntaxError(i, "expected a digit after the decimal point")
+		}
+		if maxFraction >= 0 && i-start > maxFraction {
+			return d, &ParseError{Input: s, Offset: start + maxFraction, Err: ErrTooManyDecimals}
+		}
+		d.fraction = s[start:i]
+	}
+
+	if i < end {
+		r, _ := utf8.DecodeRuneInString(s[i:])
+		return d, syntaxError(i, fmt.Sprintf("unexpected character %q", r))
+	}
+
+	return d, nil
+}
@@ -0,0 +1,137 @@
+package bahttext
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseStrict(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		want       int64
+		wantErr    error
+		wantOffset int
+	}{
+		// Valid inputs
+		{"zero", "0", 0, nil, 0},
+		{"integer", "1234", 123400, nil, 0},
+		{"grouped", "1,234", 123400, nil, 0},
+		{"grouped-large", "1,234,567.89", 123456789, nil, 0},
+		{"one-fraction-digit", "10.5", 1050, nil, 0},
+		{"two-fraction-digits", "0.05", 5, nil, 0},
+		{"plus-sign", "+12", 1200, nil, 0},
+		{"negative", "-1,000.50", -100050, nil, 0},
+		{"negative-zero", "-0.00", 0, nil, 0},
+		{"surrounding-spaces", " 123.45 ", 12345, nil, 0},
+		{"max-int64", "92,233,720,368,547,758.07", math.MaxInt64, nil, 0},
+		{"min-int64", "-92233720368547758.08", math.MinInt64, nil, 0},
+
+		// Invalid inputs
+		{"empty", "", 0, ErrEmpty, 0},
+		{"spaces", "  ", 0, ErrEmpty, 0},
+		{"scattered-separators", "1,2,3", 0, ErrSyntax, 3},
+		{"leading-separators", ",,5", 0, ErrSyntax, 0},
+		{"leading-zero-group", "01,000", 0, ErrSyntax, 0},
+		{"zero-group", "0,000", 0, ErrSyntax, 0},
+		{"double-zero", "00", 0, ErrSyntax, 0},
+		{"signed-leading-zero", "-05", 0, ErrSyntax, 1},
+		{"long-first-group", "1234,567", 0, ErrSyntax, 4},
+		{"long-group", "1,2345", 0, ErrSyntax, 5},
+		{"trailing-separator", "1,", 0, ErrSyntax, 2},
+		{"separator-in-fraction", "1.2,3", 0, ErrSyntax, 3},
+		{"hex", "0x1p4", 0, ErrSyntax, 1},
+		{"exponent", "1e3", 0, ErrSyntax, 1},
+		{"infinity", "Infinity", 0, ErrSyntax, 0},
+		{"nan", "NaN", 0, ErrSyntax, 0},
+		{"underscore", "1_000", 0, ErrSyntax, 1},
+		{"lone-sign", "-", 0, ErrSyntax, 1},
+		{"double-sign", "--1", 0, ErrSyntax, 1},
+		{"leading-dot", ".5", 0, ErrSyntax, 0},
+		{"trailing-dot", "1.", 0, ErrSyntax, 2},
+		{"inner-space", "1 000", 0, ErrSyntax, 1},
+		{"thai-digits", "๑๒๓", 0, ErrSyntax, 0},
+		{"too-many-decimals", "1.234", 0, ErrTooManyDecimals, 4},
+		{"out-of-range", "92233720368547758.08", 0, ErrRange, 0},
+		{"far-out-of-range", "-1,000,000,000,000,000,000,000", 0, ErrRange, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseStrict(tt.input)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ParseStrict(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+				}
+				var perr *ParseError
+				if !errors.As(err, &perr) {
+					t.Fatalf("ParseStrict(%q) error = %T, want *ParseError", tt.input, err)
+				}
+				if perr.Offset != tt.wantOffset {
+					t.Errorf("ParseStrict(%q) offset = %d, want %d", tt.input, perr.Offset, tt.wantOffset)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseStrict(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.want {
+				t.Errorf("ParseStrict(%q) = %d, want %d", tt.input, result, tt.want)
+			}
+		})
+	}
+}
+
+func TestWordsFromStringStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"zero", "0", "ศูนย์บาทถ้วน", false},
+		{"negative-zero", "-0.00", "ศูนย์บาทถ้วน", false},
+		{"decimal", "1,234.56", "หนึ่งพันสองร้อยสามสิบสี่บาทห้าสิบหกสตางค์", false},
+		{"one-fraction-digit", "10.1", "สิบบาทสิบสตางค์", false},
+		{"leading-zero-satang", "1,000.05", "หนึ่งพันบาทห้าสตางค์", false},
+		{"negative", "-100", "ลบหนึ่งร้อยบาทถ้วน", false},
+		{"beyond-int64", "1,000,000,000,000,000,000,000,000.01", "หนึ่งล้านล้านล้านล้านบาทหนึ่งสตางค์", false},
+
+		{"scattered-separators", "1,2,3", "", true},
+		{"exponent", "1e3", "", true},
+		{"too-many-decimals", "1.234", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := WordsFromStringStrict(tt.input)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("WordsFromStringStrict(%q) expected error, got nil", tt.input)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("WordsFromStringStrict(%q) unexpected error: %v", tt.input, err)
+				return
+			}
+
+			if result != tt.want {
+				t.Errorf("WordsFromStringStrict(%q) = %s, want %s", tt.input, result, tt.want)
+			}
+		})
+	}
+}
+
+func TestWordsFromStringStrictMessage(t *testing.T) {
+	_, err := WordsFromStringStrict("1,2,3")
+	want := `invalid number format "1,2,3" at offset 3: invalid syntax: thousands separator must be followed by exactly three digits`
+	if err == nil || err.Error() != want {
+		t.Errorf("WordsFromStringStrict(%q) error = %v, want %s", "1,2,3", err, want)
+	}
+}
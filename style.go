@@ -0,0 +1,27 @@
+package bahttext
+
+import "strconv"
+
+// ReadingStyle selects how a trailing one is read.
+type ReadingStyle int
+
+const (
+	// CommonStyle reads a trailing one as "เอ็ด" whenever anything comes
+	// before it, as Excel's BAHTTEXT() does: 101 reads "หนึ่งร้อยเอ็ด".
+	CommonStyle ReadingStyle = iota
+	// RoyalInstitute follows the Royal Institute guidance and reads a
+	// trailing one as "หนึ่ง" when the tens digit is zero: 101 reads
+	// "หนึ่งร้อยหนึ่ง" and 1,000,001 reads "หนึ่งล้านหนึ่ง". A trailing one
+	// after a non-zero tens digit is still "เอ็ด", as in 11, 21 and 111.
+	RoyalInstitute
+)
+
+func (s ReadingStyle) String() string {
+	switch s {
+	case CommonStyle:
+		return "Common"
+	case RoyalInstitute:
+		return "RoyalInstitute"
+	}
+	return "ReadingStyle(" + strconv.Itoa(int(s)) + ")"
+}
@@ -0,0 +1,73 @@
+package bahttext
+
+import "testing"
+
+// TestReadingStyleDifferences documents every kind of amount where the Royal
+// Institute style differs from the common style, and some where it does not.
+func TestReadingStyleDifferences(t *testing.T) {
+	royal, err := New(WithReadingStyle(RoyalInstitute))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		input  int64
+		common string
+		royal  string
+	}{
+		// A trailing one after a zero tens digit
+		{"one-hundred-one", 101, "หนึ่งร้อยเอ็ด", "หนึ่งร้อยหนึ่ง"},
+		{"two-hundred-one", 201, "สองร้อยเอ็ด", "สองร้อยหนึ่ง"},
+		{"one-thousand-one", 1001, "หนึ่งพันเอ็ด", "หนึ่งพันหนึ่ง"},
+		{"one-hundred-thousand-one", 100_001, "หนึ่งแสนเอ็ด", "หนึ่งแสนหนึ่ง"},
+		{"one-million-one", 1_000_001, "หนึ่งล้านเอ็ด", "หนึ่งล้านหนึ่ง"},
+		{"ten-million-one", 10_000_001, "สิบล้านเอ็ด", "สิบล้านหนึ่ง"},
+		{"million-group-one", 1_000_001_000_000, "หนึ่งล้านเอ็ดล้าน", "หนึ่งล้านหนึ่งล้าน"},
+
+		// No difference
+		{"one", 1, "หนึ่ง", "หนึ่ง"},
+		{"eleven", 11, "สิบเอ็ด", "สิบเอ็ด"},
+		{"twenty-one", 21, "ยี่สิบเอ็ด", "ยี่สิบเอ็ด"},
+		{"one-hundred-eleven", 111, "หนึ่งร้อยสิบเอ็ด", "หนึ่งร้อยสิบเอ็ด"},
+		{"one-million-eleven", 1_000_011, "หนึ่งล้านสิบเอ็ด", "หนึ่งล้านสิบเอ็ด"},
+		{"one-hundred", 100, "หนึ่งร้อย", "หนึ่งร้อย"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Number(tt.input); got != tt.common {
+				t.Errorf("Number(%d) = %s, want %s", tt.input, got, tt.common)
+			}
+			if got := royal.Number(tt.input); got != tt.royal {
+				t.Errorf("RoyalInstitute Number(%d) = %s, want %s", tt.input, got, tt.royal)
+			}
+		})
+	}
+}
+
+func TestReadingStyleWords(t *testing.T) {
+	royal, err := New(WithReadingStyle(RoyalInstitute))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		input float64
+		want  string
+	}{
+		{101, "หนึ่งร้อยหนึ่งบาทถ้วน"},
+		{101.01, "หนึ่งร้อยหนึ่งบาทหนึ่งสตางค์"},
+		{1.21, "หนึ่งบาทยี่สิบเอ็ดสตางค์"},
+	}
+
+	for _, tt := range tests {
+		if got := royal.Words(tt.input); got != tt.want {
+			t.Errorf("RoyalInstitute Words(%v) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+
+	if _, err := New(WithReadingStyle(ReadingStyle(7))); err == nil {
+		t.Errorf("New(WithReadingStyle(7)) expected error, got nil")
+	}
+}
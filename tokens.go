@@ -0,0 +1,157 @@
+package bahttext
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// TokenKind tells what part of a reading a Token is.
+type TokenKind int
+
+const (
+	// TokenDigit is the word for a digit, such as "หนึ่ง", or for zero. An
+	// English tens word, such as "thirty" or "thirty-four", is the word for
+	// its tens digit.
+	TokenDigit TokenKind = iota
+	// TokenPlace is the word for a place within a group, such as "สิบ",
+	// "ร้อย" or "พัน".
+	TokenPlace
+	// TokenScale is the word that names a whole group of digits, such as
+	// "ล้าน" or "thousand".
+	TokenScale
+	// TokenSign marks a negative amount: the sign word, a parenthesis or
+	// the annotation of the NegativeStyle.
+	TokenSign
+	// TokenUnit is a currency word, such as "บาท" or "สตางค์".
+	TokenUnit
+	// TokenSuffix is the word ending whole amounts, such as "ถ้วน".
+	TokenSuffix
+	// TokenSpecial is a word that replaces the usual digit word, such as
+	// "ยี่" in ยี่สิบ or "เอ็ด" in สิบเอ็ด, or an English word from "ten" to
+	// "nineteen", which has the tens digit one.
+	TokenSpecial
+	// TokenSeparator is the text between two words, such as the space of
+	// English readings.
+	TokenSeparator
+	// TokenWord is any other word, such as the English "and", and the
+	// number words of Spellers that do not break them down.
+	TokenWord
+)
+
+var tokenKindNames = []string{"Digit", "Place", "Scale", "Sign", "Unit", "Suffix", "Special", "Separator", "Word"}
+
+func (k TokenKind) String() string {
+	if k < 0 || int(k) >= len(tokenKindNames) {
+		return "TokenKind(" + strconv.Itoa(int(k)) + ")"
+	}
+	return tokenKindNames[k]
+}
+
+// Token is one part of a reading. Digit and Position tell the digit a token
+// came from: Position is the power of ten the digit stands for, so the พัน of
+// 1234.56 has Digit 1 and Position 3, and the หก has Digit 6 and Position -2.
+// A scale word has the Position of the group it names. Tokens that do not
+// come from a digit have Digit -1.
+//
+// The words of a custom Speller are not broken down: they have Digit -1 and
+// the Position of the lowest digit of their group.
+type Token struct {
+	Text     string
+	Kind     TokenKind
+	Digit    int
+	Position int
+}
+
+// Tokens converts a float64 amount into the parts of its Thai word
+// representation, for highlighting them or feeding them to a speech engine.
+// The texts of the tokens concatenate to Words(money), and Tokens panics
+// where Words does.
+//
+// Example usage:
+//
+//	for _, token := range baht.Tokens(21) {
+//		fmt.Println(token.Kind, token.Text)
+//	}
+func Tokens(money float64) []Token {
+	return std.Tokens(money)
+}
+
+// Tokens is like the package-level Tokens but uses c's options.
+func (c *Converter) Tokens(money float64) []Token {
+	tokens, err := c.TokensE(money)
+	if err != nil {
+		panic(err)
+	}
+	return tokens
+}
+
+// TokensE is like Tokens but returns the error WordsE would return instead of
+// panicking.
+func (c *Converter) TokensE(money float64) ([]Token, error) {
+	c = c.ready()
+	negative, baht, satang, err := c.split(money)
+	if err != nil {
+		return nil, err
+	}
+	return c.bahtTokens(negative, baht, satang)
+}
+
+// word returns a token that does not come from a digit.
+func word(text string, kind TokenKind) Token {
+	return Token{Text: text, Kind: kind, Digit: -1}
+}
+
+// tokenText concatenates the texts of tokens.
+func tokenText(tokens []Token) string {
+	var b strings.Builder
+	for _, t := range tokens {
+		b.WriteString(t.Text)
+	}
+	return b.String()
+}
+
+// separate drops the tokens with empty text and puts the separator of c's
+// speller between the others, the way join does for words.
+func (c *Converter) separate(tokens []Token) []Token {
+	space := c.separator()
+	kept := make([]Token, 0, 2*len(tokens))
+	for _, t := range tokens {
+		if t.Text == "" {
+			continue
+		}
+		if len(kept) > 0 && space != "" {
+			kept = append(kept, word(space, TokenSeparator))
+		}
+		kept = append(kept, t)
+	}
+	return kept
+}
+
+// capitalizeTokens applies the letter case option of c's speller to tokens,
+// giving the same text as capitalizing their concatenation.
+func (c *Converter) capitalizeTokens(tokens []Token) {
+	s, ok := c.speller().(capitalizer)
+	if !ok {
+		return
+	}
+
+	mode := s.capitalization()
+	first := true
+	for i, t := range tokens {
+		if t.Kind == TokenSeparator {
+			continue
+		}
+		switch {
+		case mode != CapitalizeSentence:
+			if !(mode == CapitalizeTitle && !first && t.Text == "and") {
+				tokens[i].Text = capitalize(t.Text, mode)
+			}
+		case first:
+			tokens[i].Text = upperFirst(t.Text)
+		}
+		if strings.ContainsFunc(t.Text, unicode.IsLetter) {
+			first = false
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package bahttext
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokens(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  []Option
+		input float64
+		want  []Token
+	}{
+		{"zero", nil, 0, []Token{
+			{"ศูนย์", TokenDigit, 0, 0},
+			{"บาท", TokenUnit, -1, 0},
+			{"ถ้วน", TokenSuffix, -1, 0},
+		}},
+		{"twenty-one", nil, 21, []Token{
+			{"ยี่", TokenSpecial, 2, 1},
+			{"สิบ", TokenPlace, 2, 1},
+			{"เอ็ด", TokenSpecial, 1, 0},
+			{"บาท", TokenUnit, -1, 0},
+			{"ถ้วน", TokenSuffix, -1, 0},
+		}},
+		{"satang", nil, 1234.56, []Token{
+			{"หนึ่ง", TokenDigit, 1, 3},
+			{"พัน", TokenPlace, 1, 3},
+			{"สอง", TokenDigit, 2, 2},
+			{"ร้อย", TokenPlace, 2, 2},
+			{"สาม", TokenDigit, 3, 1},
+			{"สิบ", TokenPlace, 3, 1},
+			{"สี่", TokenDigit, 4, 0},
+			{"บาท", TokenUnit, -1, 0},
+			{"ห้า", TokenDigit, 5, -1},
+			{"สิบ", TokenPlace, 5, -1},
+			{"หก", TokenDigit, 6, -2},
+			{"สตางค์", TokenUnit, -1, 0},
+		}},
+		{"million", nil, 11_000_001, []Token{
+			{"สิบ", TokenPlace, 1, 7},
+			{"เอ็ด", TokenSpecial, 1, 6},
+			{"ล้าน", TokenScale, -1, 6},
+			{"เอ็ด", TokenSpecial, 1, 0},
+			{"บาท", TokenUnit, -1, 0},
+			{"ถ้วน", TokenSuffix, -1, 0},
+		}},
+		{"negative", nil, -5, []Token{
+			{"ลบ", TokenSign, -1, 0},
+			{"ห้า", TokenDigit, 5, 0},
+			{"บาท", TokenUnit, -1, 0},
+			{"ถ้วน", TokenSuffix, -1, 0},
+		}},
+		{"parentheses", []Option{WithNegativeStyle(NegativeParentheses, NegativeZeroSigned)}, -5, []Token{
+			{"(", TokenSign, -1, 0},
+			{"ห้า", TokenDigit, 5, 0},
+			{"บาท", TokenUnit, -1, 0},
+			{"ถ้วน", TokenSuffix, -1, 0},
+			{")", TokenSign, -1, 0},
+		}},
+		{"northern", []Option{WithDialect(Northern)}, 20, []Token{
+			{"ซาว", TokenSpecial, 2, 1},
+			{"บาท", TokenUnit, -1, 0},
+			{"ถ้วน", TokenSuffix, -1, 0},
+		}},
+		{"royal-institute", []Option{WithReadingStyle(RoyalInstitute)}, 101, []Token{
+			{"หนึ่ง", TokenDigit, 1, 2},
+			{"ร้อย", TokenPlace, 1, 2},
+			{"หนึ่ง", TokenDigit, 1, 0},
+			{"บาท", TokenUnit, -1, 0},
+			{"ถ้วน", TokenSuffix, -1, 0},
+		}},
+		{"royal-institute-after-tens", []Option{WithReadingStyle(RoyalInstitute)}, 111, []Token{
+			{"หนึ่ง", TokenDigit, 1, 2},
+			{"ร้อย", TokenPlace, 1, 2},
+			{"สิบ", TokenPlace, 1, 1},
+			{"เอ็ด", TokenSpecial, 1, 0},
+			{"บาท", TokenUnit, -1, 0},
+			{"ถ้วน", TokenSuffix, -1, 0},
+		}},
+		{"omit-leading-one", []Option{OmitLeadingOne()}, 101, []Token{
+			{"ร้อย", TokenPlace, 1, 2},
+			{"เอ็ด", TokenSpecial, 1, 0},
+			{"บาท", TokenUnit, -1, 0},
+			{"ถ้วน", TokenSuffix, -1, 0},
+		}},
+		{"omit-leading-one-million", []Option{OmitLeadingOne()}, 1_100_000, []Token{
+			{"ล้าน", TokenScale, -1, 6},
+			{"หนึ่ง", TokenDigit, 1, 5},
+			{"แสน", TokenPlace, 1, 5},
+			{"บาท", TokenUnit, -1, 0},
+			{"ถ้วน", TokenSuffix, -1, 0},
+		}},
+		{"lao", []Option{WithLanguage(Lao)}, 21.5, []Token{
+			{"ຊາວ", TokenSpecial, 2, 1},
+			{"ເອັດ", TokenSpecial, 1, 0},
+			{"ກີບ", TokenUnit, -1, 0},
+			{"ຫ້າ", TokenDigit, 5, -1},
+			{"ສິບ", TokenPlace, 5, -1},
+			{"ອັດ", TokenUnit, -1, 0},
+		}},
+		{"lao-million", []Option{WithLanguage(Lao)}, 1_000_101, []Token{
+			{"ໜຶ່ງ", TokenDigit, 1, 6},
+			{"ລ້ານ", TokenScale, -1, 6},
+			{"ໜຶ່ງ", TokenDigit, 1, 2},
+			{"ຮ້ອຍ", TokenPlace, 1, 2},
+			{"ເອັດ", TokenSpecial, 1, 0},
+			{"ກີບ", TokenUnit, -1, 0},
+		}},
+		{"chinese", []Option{WithLanguage(Chinese)}, 1001.05, []Token{
+			{"泰铢", TokenUnit, -1, 0},
+			{"壹", TokenDigit, 1, 3},
+			{"仟", TokenPlace, 1, 3},
+			{"零", TokenDigit, 0, 1},
+			{"壹", TokenDigit, 1, 0},
+			{"元", TokenUnit, -1, 0},
+			{"零", TokenDigit, 0, -1},
+			{"伍", TokenDigit, 5, -2},
+			{"分", TokenUnit, 5, -2},
+		}},
+		{"chinese-wan", []Option{WithLanguage(Chinese)}, 1_100_000, []Token{
+			{"泰铢", TokenUnit, -1, 0},
+			{"壹", TokenDigit, 1, 6},
+			{"佰", TokenPlace, 1, 6},
+			{"壹", TokenDigit, 1, 5},
+			{"拾", TokenPlace, 1, 5},
+			{"万", TokenScale, -1, 4},
+			{"元", TokenUnit, -1, 0},
+			{"整", TokenSuffix, -1, 0},
+		}},
+		{"chinese-skipped-group", []Option{WithLanguage(Chinese)}, 100_000_002, []Token{
+			{"泰铢", TokenUnit, -1, 0},
+			{"壹", TokenDigit, 1, 8},
+			{"亿", TokenScale, -1, 8},
+			{"零", TokenDigit, 0, 1},
+			{"贰", TokenDigit, 2, 0},
+			{"元", TokenUnit, -1, 0},
+			{"整", TokenSuffix, -1, 0},
+		}},
+		{"english", []Option{WithLanguage(English)}, 2001.5, []Token{
+			{"Two", TokenDigit, 2, 3},
+			{" ", TokenSeparator, -1, 0},
+			{"thousand", TokenScale, -1, 3},
+			{" ", TokenSeparator, -1, 0},
+			{"one", TokenDigit, 1, 0},
+			{" ", TokenSeparator, -1, 0},
+			{"baht", TokenUnit, -1, 0},
+			{" ", TokenSeparator, -1, 0},
+			{"and", TokenWord, -1, 0},
+			{" ", TokenSeparator, -1, 0},
+			{"fifty", TokenDigit, 5, -1},
+			{" ", TokenSeparator, -1, 0},
+			{"satang", TokenUnit, -1, 0},
+		}},
+		{"english-hyphen", []Option{WithLanguage(English)}, 21.50, []Token{
+			{"Twenty-one", TokenDigit, 2, 1},
+			{" ", TokenSeparator, -1, 0},
+			{"baht", TokenUnit, -1, 0},
+			{" ", TokenSeparator, -1, 0},
+			{"and", TokenWord, -1, 0},
+			{" ", TokenSeparator, -1, 0},
+			{"fifty", TokenDigit, 5, -1},
+			{" ", TokenSeparator, -1, 0},
+			{"satang", TokenUnit, -1, 0},
+		}},
+		{"english-hundreds", []Option{WithLanguage(English), EnglishHyphen(false)}, 315_021, []Token{
+			{"Three", TokenDigit, 3, 5},
+			{" ", TokenSeparator, -1, 0},
+			{"hundred", TokenPlace, 3, 5},
+			{" ", TokenSeparator, -1, 0},
+			{"fifteen", TokenSpecial, 1, 4},
+			{" ", TokenSeparator, -1, 0},
+			{"thousand", TokenScale, -1, 3},
+			{" ", TokenSeparator, -1, 0},
+			{"twenty", TokenDigit, 2, 1},
+			{" ", TokenSeparator, -1, 0},
+			{"one", TokenDigit, 1, 0},
+			{" ", TokenSeparator, -1, 0},
+			{"baht", TokenUnit, -1, 0},
+		}},
+		{"custom-speller", []Option{WithSpeller(indonesianSpeller{})}, 1_021, []Token{
+			{"seribu", TokenWord, -1, 3},
+			{" ", TokenSeparator, -1, 0},
+			{"dua", TokenWord, -1, 0},
+			{" ", TokenSeparator, -1, 0},
+			{"puluh", TokenWord, -1, 0},
+			{" ", TokenSeparator, -1, 0},
+			{"satu", TokenWord, -1, 0},
+			{" ", TokenSeparator, -1, 0},
+			{"rupiah", TokenUnit, -1, 0},
+		}},
+		{"rtgs", []Option{WithLanguage(RTGS), RTGSSeparator("-")}, 12, []Token{
+			{"sip", TokenPlace, 1, 1},
+			{"-", TokenSeparator, -1, 0},
+			{"song", TokenDigit, 2, 0},
+			{"-", TokenSeparator, -1, 0},
+			{"baht", TokenUnit, -1, 0},
+			{"-", TokenSeparator, -1, 0},
+			{"thuan", TokenSuffix, -1, 0},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(tt.opts...)
+			if err != nil {
+				t.Fatalf("New() unexpected error: %v", err)
+			}
+			got := c.Tokens(tt.input)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Tokens(%v) = %v, want %v", tt.input, got, tt.want)
+			}
+			for _, token := range got {
+				if token.Text == "" {
+					t.Errorf("Tokens(%v) has an empty token: %v", tt.input, got)
+				}
+			}
+		})
+	}
+}
+
+func TestTokensE(t *testing.T) {
+	c, err := New(WithNegativeStyle(NegativeReject, NegativeZeroSigned))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	if _, err := c.TokensE(-1); err == nil {
+		t.Error("TokensE(-1) expected an error")
+	}
+	if got, want := Tokens(1), []Token{{"หนึ่ง", TokenDigit, 1, 0}, {"บาท", TokenUnit, -1, 0}, {"ถ้วน", TokenSuffix, -1, 0}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Tokens(1) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,108 @@
+package bahttext
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// WithSign makes the Converter start negative amounts with word instead of
+// "ลบ", for example "ติดลบ".
+func WithSign(word string) Option {
+	return func(c *Converter) error {
+		if err := checkWord("sign", word); err != nil {
+			return err
+		}
+		c.minus = word
+		return nil
+	}
+}
+
+// WithMajorUnit makes the Converter read the whole amount in word instead of
+// "บาท".
+func WithMajorUnit(word string) Option {
+	return func(c *Converter) error {
+		if err := checkWord("major unit", word); err != nil {
+			return err
+		}
+		c.baht = word
+		return nil
+	}
+}
+
+// WithMinorUnit makes the Converter read the fraction in word instead of
+// "สตางค์", for example the abbreviation "สต.".
+func WithMinorUnit(word string) Option {
+	return func(c *Converter) error {
+		if err := checkWord("minor unit", word); err != nil {
+			return err
+		}
+		c.satang = word
+		return nil
+	}
+}
+
+// WithExactSuffix makes the Converter end amounts without a fraction with
+// word instead of "ถ้วน", for example "ตรง".
+func WithExactSuffix(word string) Option {
+	return func(c *Converter) error {
+		if err := checkWord("exact suffix", word); err != nil {
+			return err
+		}
+		c.exact = word
+		return nil
+	}
+}
+
+// WithZeroWord makes the Converter read zero as word instead of "ศูนย์",
+// both for a zero amount and for zero digits after the decimal point.
+func WithZeroWord(word string) Option {
+	return func(c *Converter) error {
+		if err := checkWord("zero", word); err != nil {
+			return err
+		}
+		c.zero = word
+		return nil
+	}
+}
+
+// With returns a copy of c changed by opts, leaving c untouched. It lets a
+// single call use a different word without building a Converter from scratch.
+//
+// Example usage:
+//
+//	c, err := baht.New(baht.WithExactSuffix("ตรง"))
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	receipt, err := c.With(baht.WithMinorUnit("สต."))
+func (c *Converter) With(opts ...Option) (*Converter, error) {
+	d := *c.ready()
+	for _, opt := range opts {
+		if err := opt(&d); err != nil {
+			return nil, err
+		}
+	}
+	return &d, nil
+}
+
+// checkWord rejects words that cannot be printed as part of a reading: empty
+// words, invalid UTF-8, surrounding white space, and words containing digits
+// or control characters.
+func checkWord(kind, word string) error {
+	switch {
+	case word == "":
+		return fmt.Errorf("empty %s word", kind)
+	case !utf8.ValidString(word):
+		return fmt.Errorf("invalid UTF-8 in %s word %q", kind, word)
+	case strings.TrimSpace(word) != word:
+		return fmt.Errorf("%s word %q has surrounding white space", kind, word)
+	}
+	for _, r := range word {
+		if unicode.IsDigit(r) || unicode.IsControl(r) {
+			return fmt.Errorf("%s word %q contains %q", kind, word, r)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package bahttext
+
+import "testing"
+
+func TestVocabulary(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  []Option
+		input float64
+		want  string
+	}{
+		{"exact-suffix", []Option{WithExactSuffix("ตรง")}, 100, "หนึ่งร้อยบาทตรง"},
+		{"sign", []Option{WithSign("ติดลบ")}, -100, "ติดลบหนึ่งร้อยบาทถ้วน"},
+		{"minor-unit", []Option{WithMinorUnit("สต.")}, 1.5, "หนึ่งบาทห้าสิบสต."},
+		{"major-unit", []Option{WithMajorUnit("ดอลลาร์")}, 2, "สองดอลลาร์ถ้วน"},
+		{"zero", []Option{WithZeroWord("สูญ")}, 0, "สูญบาทถ้วน"},
+		{"zero-satang", []Option{WithZeroWord("สูญ")}, 0.25, "สูญบาทยี่สิบห้าสตางค์"},
+		{"combined", []Option{WithSign("ติดลบ"), WithMinorUnit("สต."), WithExactSuffix("ตรง")}, -1.01, "ติดลบหนึ่งบาทหนึ่งสต."},
+		{"excel-minor-unit", []Option{ExcelCompatible(), WithMinorUnit("สต.")}, 0.5, "ห้าสิบสต."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(tt.opts...)
+			if err != nil {
+				t.Fatalf("New() unexpected error: %v", err)
+			}
+			if got := c.Words(tt.input); got != tt.want {
+				t.Errorf("Words(%v) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVocabularyZeroInDecimal(t *testing.T) {
+	c, err := New(WithZeroWord("สูญ"))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	got, err := c.Decimal("0.05")
+	if err != nil {
+		t.Fatalf("Decimal() unexpected error: %v", err)
+	}
+	if want := "สูญจุดสูญห้า"; got != want {
+		t.Errorf("Decimal(0.05) = %s, want %s", got, want)
+	}
+}
+
+func TestVocabularyInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  Option
+	}{
+		{"empty-sign", WithSign("")},
+		{"empty-major-unit", WithMajorUnit("")},
+		{"empty-minor-unit", WithMinorUnit("")},
+		{"empty-exact-suffix", WithExactSuffix("")},
+		{"empty-zero", WithZeroWord("")},
+		{"leading-space", WithSign(" ลบ")},
+		{"trailing-space", WithExactSuffix("ตรง ")},
+		{"digit", WithMinorUnit("สต1")},
+		{"thai-digit", WithMajorUnit("บาท๑")},
+		{"control", WithSign("ลบ\n")},
+		{"invalid-utf8", WithZeroWord("\xff")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(tt.opt); err == nil {
+				t.Errorf("New() expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestConverterWith(t *testing.T) {
+	base, err := New(WithExactSuffix("ตรง"))
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	receipt, err := base.With(WithMinorUnit("สต."))
+	if err != nil {
+		t.Fatalf("With() unexpected error: %v", err)
+	}
+
+	if got, want := receipt.Words(1.5), "หนึ่งบาทห้าสิบสต."; got != want {
+		t.Errorf("With Words(1.5) = %s, want %s", got, want)
+	}
+	if got, want := receipt.Words(1), "หนึ่งบาทตรง"; got != want {
+		t.Errorf("With Words(1) = %s, want %s", got, want)
+	}
+	if got, want := base.Words(1.5), "หนึ่งบาทห้าสิบสตางค์"; got != want {
+		t.Errorf("base Words(1.5) = %s, want %s, With must not change the receiver", got, want)
+	}
+
+	if _, err := base.With(WithSign("")); err == nil {
+		t.Errorf("With(WithSign(\"\")) expected error, got nil")
+	}
+}